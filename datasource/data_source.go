@@ -2,6 +2,8 @@ package datasource
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // DataSource represents an instance of a data source type. This is the core
@@ -12,6 +14,7 @@ import (
 //   - Configure: Include provider-level data or clients.
 //   - Validation: Schema-based or entire configuration
 //     via DataSourceWithConfigValidators or DataSourceWithValidateConfig.
+//   - Warning Suppression: DataSourceWithSuppressedWarningPaths
 type DataSource interface {
 	// Metadata should return the full name of the data source, such as
 	// examplecloud_thing.
@@ -57,6 +60,25 @@ type DataSourceWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// DataSourceWithSuppressedWarningPaths is an interface type that extends
+// DataSource to suppress framework-generated validation warnings, such as
+// attribute and block deprecation warnings, for specific attributes and
+// blocks of the data source configuration. The intended use case is enabling
+// practitioners with large configurations that intentionally use deprecated
+// attributes during a migration to opt out of the warnings.
+//
+// The path expressions are only matched against this data source
+// configuration. Error diagnostics and any diagnostics returned by
+// provider-defined validators are never suppressed.
+type DataSourceWithSuppressedWarningPaths interface {
+	DataSource
+
+	// SuppressedWarningPaths should return the path expressions of
+	// attributes and blocks which should not raise framework-generated
+	// validation warnings.
+	SuppressedWarningPaths(context.Context) path.Expressions
+}
+
 // DataSourceWithValidateConfig is an interface type that extends DataSource to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
@@ -13,6 +13,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                              = Int64Attribute{}
+	_ fwschema.AttributeWithAliases          = Int64Attribute{}
 	_ fwxschema.AttributeWithInt64Validators = Int64Attribute{}
 )
 
@@ -108,6 +109,21 @@ type Int64Attribute struct {
 	//
 	DeprecationMessage string
 
+	// Aliases defines alternative names for this attribute, such as the
+	// previous name of a renamed attribute, which practitioners can
+	// configure instead of this attribute during a migration. Each alias is
+	// included in the schema as a deprecated, Optional attribute of the same
+	// type. Configuring an alias raises a warning diagnostic directing the
+	// practitioner to this attribute name, while configuring both this
+	// attribute and an alias raises an error diagnostic. This attribute must
+	// be Optional when aliases are defined.
+	//
+	// A configured alias value is read as the value of this attribute, so any
+	// data model, such as a struct with tfsdk tags, only includes a field for
+	// this attribute name. Written values are sent to Terraform under the
+	// configured alias name.
+	Aliases []string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -137,6 +153,11 @@ func (a Int64Attribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAliases returns the Aliases field value.
+func (a Int64Attribute) GetAliases() []string {
+	return a.Aliases
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Int64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -15,6 +15,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                       = ListNestedAttribute{}
+	_ fwschema.AttributeWithAliases         = ListNestedAttribute{}
 	_ fwxschema.AttributeWithListValidators = ListNestedAttribute{}
 )
 
@@ -127,6 +128,21 @@ type ListNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// Aliases defines alternative names for this attribute, such as the
+	// previous name of a renamed attribute, which practitioners can
+	// configure instead of this attribute during a migration. Each alias is
+	// included in the schema as a deprecated, Optional attribute of the same
+	// type. Configuring an alias raises a warning diagnostic directing the
+	// practitioner to this attribute name, while configuring both this
+	// attribute and an alias raises an error diagnostic. This attribute must
+	// be Optional when aliases are defined.
+	//
+	// A configured alias value is read as the value of this attribute, so any
+	// data model, such as a struct with tfsdk tags, only includes a field for
+	// this attribute name. Written values are sent to Terraform under the
+	// configured alias name.
+	Aliases []string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -162,6 +178,11 @@ func (a ListNestedAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAliases returns the Aliases field value.
+func (a ListNestedAttribute) GetAliases() []string {
+	return a.Aliases
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a ListNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -125,7 +126,8 @@ func (s Schema) TypeAtTerraformPath(ctx context.Context, p *tftypes.AttributePat
 	return fwschema.SchemaTypeAtTerraformPath(ctx, s, p)
 }
 
-// Validate verifies that the schema is not using a reserved field name for a top-level attribute.
+// Validate verifies that the schema is not using a reserved field name for a top-level attribute
+// and that no attribute is defined with an invalid combination of Computed, Optional, and Required.
 func (s Schema) Validate() diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -151,6 +153,16 @@ func (s Schema) Validate() diag.Diagnostics {
 			)
 		}
 
+		for _, alias := range fwschema.AttributeAliases(v) {
+			if _, ok := reservedFieldNames[alias]; ok {
+				diags.AddAttributeError(
+					path.Root(k),
+					"Schema Using Reserved Field Name",
+					fmt.Sprintf("%q is a reserved field name", alias),
+				)
+			}
+		}
+
 		d := validateAttributeFieldName(path.Root(k), k, v)
 
 		diags.Append(d...)
@@ -172,9 +184,20 @@ func (s Schema) Validate() diag.Diagnostics {
 		diags.Append(d...)
 	}
 
+	diags.Append(fwschema.SchemaValidateImplementation(s)...)
+
 	return diags
 }
 
+// Validators returns the Description of every validator declared in the
+// schema, keyed by the path expression string of the attribute, block, or
+// nested object declaring them, such as "list_attribute[*].nested_attribute".
+// Validators are not called, so this is suitable for tooling such as
+// documentation generators and policy linters.
+func (s Schema) Validators(ctx context.Context) map[string][]string {
+	return fwxschema.SchemaValidatorDescriptions(ctx, s)
+}
+
 // validFieldNameRegex is used to verify that name used for attributes and blocks
 // comply with the defined regular expression.
 var validFieldNameRegex = regexp.MustCompile("^[a-z0-9_]+$")
@@ -192,6 +215,16 @@ func validateAttributeFieldName(path path.Path, name string, attr fwschema.Attri
 		)
 	}
 
+	for _, alias := range fwschema.AttributeAliases(attr) {
+		if !validFieldNameRegex.MatchString(alias) {
+			diags.AddAttributeError(
+				path,
+				"Invalid Schema Field Name",
+				fmt.Sprintf("Field name %q is invalid, the only allowed characters are a-z, 0-9 and _. This is always a problem with the provider and should be reported to the provider developer.", alias),
+			)
+		}
+	}
+
 	if na, ok := attr.(fwschema.NestedAttribute); ok {
 		nestedObject := na.GetNestedObject()
 
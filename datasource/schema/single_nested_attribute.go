@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                         = SingleNestedAttribute{}
+	_ fwschema.AttributeWithAliases           = SingleNestedAttribute{}
 	_ fwxschema.AttributeWithObjectValidators = SingleNestedAttribute{}
 )
 
@@ -122,6 +123,21 @@ type SingleNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// Aliases defines alternative names for this attribute, such as the
+	// previous name of a renamed attribute, which practitioners can
+	// configure instead of this attribute during a migration. Each alias is
+	// included in the schema as a deprecated, Optional attribute of the same
+	// type. Configuring an alias raises a warning diagnostic directing the
+	// practitioner to this attribute name, while configuring both this
+	// attribute and an alias raises an error diagnostic. This attribute must
+	// be Optional when aliases are defined.
+	//
+	// A configured alias value is read as the value of this attribute, so any
+	// data model, such as a struct with tfsdk tags, only includes a field for
+	// this attribute name. Written values are sent to Terraform under the
+	// configured alias name.
+	Aliases []string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -144,7 +160,11 @@ func (a SingleNestedAttribute) ApplyTerraform5AttributePathStep(step tftypes.Att
 		return nil, fmt.Errorf("cannot apply step %T to SingleNestedAttribute", step)
 	}
 
-	attribute, ok := a.Attributes[string(name)]
+	if attribute, ok := a.Attributes[string(name)]; ok {
+		return attribute, nil
+	}
+
+	attribute, ok := fwschema.AttributeNamed(a.GetAttributes(), string(name))
 
 	if !ok {
 		return nil, fmt.Errorf("no attribute %q on SingleNestedAttribute", name)
@@ -168,6 +188,11 @@ func (a SingleNestedAttribute) GetAttributes() fwschema.UnderlyingAttributes {
 	return schemaAttributes(a.Attributes)
 }
 
+// GetAliases returns the Aliases field value.
+func (a SingleNestedAttribute) GetAliases() []string {
+	return a.Aliases
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a SingleNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -208,6 +233,10 @@ func (a SingleNestedAttribute) GetType() attr.Type {
 
 	for name, attribute := range a.Attributes {
 		attrTypes[name] = attribute.GetType()
+
+		for _, alias := range fwschema.AttributeAliases(attribute) {
+			attrTypes[alias] = attrTypes[name]
+		}
 	}
 
 	return types.ObjectType{
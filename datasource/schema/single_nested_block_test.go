@@ -52,6 +52,28 @@ func TestSingleNestedBlockApplyTerraform5AttributePathStep(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"AttributeName-nested-attribute": {
+			block: schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"testnestedattr": schema.StringAttribute{},
+							},
+						},
+					},
+				},
+			},
+			step: tftypes.AttributeName("testattr"),
+			expected: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"testnestedattr": schema.StringAttribute{},
+					},
+				},
+			},
+			expectedError: nil,
+		},
 		"AttributeName-missing": {
 			block: schema.SingleNestedBlock{
 				Attributes: map[string]schema.Attribute{
@@ -416,6 +438,30 @@ func TestSingleNestedBlockType(t *testing.T) {
 				},
 			},
 		},
+		"nested-attribute": {
+			block: schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"testnestedattr": schema.StringAttribute{},
+							},
+						},
+					},
+				},
+			},
+			expected: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"testattr": types.ListType{
+						ElemType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"testnestedattr": types.StringType,
+							},
+						},
+					},
+				},
+			},
+		},
 		// "custom-type": {
 		// 	block: schema.SingleNestedBlock{
 		// 		CustomType: testtypes.SingleType{},
@@ -1,6 +1,10 @@
 package diag
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -122,3 +126,144 @@ func (diags Diagnostics) Warnings() Diagnostics {
 
 	return dd
 }
+
+// Filter returns all the Diagnostic in Diagnostics for which the keep function
+// returns true. The Diagnostics is not modified.
+func (diags Diagnostics) Filter(keep func(Diagnostic) bool) Diagnostics {
+	dd := Diagnostics{}
+
+	for _, d := range diags {
+		if keep(d) {
+			dd = append(dd, d)
+		}
+	}
+
+	return dd
+}
+
+// WithoutAttributePath returns all the Diagnostic in Diagnostics that are not
+// associated with the given path or any path nested underneath it. Diagnostic
+// without a path are always returned. The Diagnostics is not modified.
+func (diags Diagnostics) WithoutAttributePath(p path.Path) Diagnostics {
+	return diags.Filter(func(d Diagnostic) bool {
+		dWithPath, ok := d.(DiagnosticWithPath)
+
+		if !ok {
+			return true
+		}
+
+		return !pathHasPrefix(dWithPath.Path(), p)
+	})
+}
+
+// AggregateWarnings returns the Diagnostics with all warning Diagnostic which
+// have an attribute path and an identical summary and detail collapsed into
+// a single warning Diagnostic without a path, positioned at the first
+// occurrence. The aggregated warning detail lists up to maxPaths of the
+// affected paths in sorted order, followed by the number of remaining paths.
+// Warnings which occur at only one path, warnings without a path, and error
+// Diagnostic are never aggregated. The Diagnostics is not modified.
+func (diags Diagnostics) AggregateWarnings(maxPaths int) Diagnostics {
+	type warningKey struct {
+		summary string
+		detail  string
+	}
+
+	warningPaths := make(map[warningKey]path.Paths)
+
+	for _, d := range diags {
+		dWithPath, ok := d.(DiagnosticWithPath)
+
+		if !ok || d.Severity() != SeverityWarning {
+			continue
+		}
+
+		key := warningKey{summary: d.Summary(), detail: d.Detail()}
+
+		warningPaths[key] = append(warningPaths[key], dWithPath.Path())
+	}
+
+	var result Diagnostics
+
+	aggregated := make(map[warningKey]bool)
+
+	for _, d := range diags {
+		if _, ok := d.(DiagnosticWithPath); !ok || d.Severity() != SeverityWarning {
+			result = append(result, d)
+
+			continue
+		}
+
+		key := warningKey{summary: d.Summary(), detail: d.Detail()}
+		paths := warningPaths[key]
+
+		if len(paths) < 2 {
+			result = append(result, d)
+
+			continue
+		}
+
+		if aggregated[key] {
+			continue
+		}
+
+		aggregated[key] = true
+
+		result = append(result, NewWarningDiagnostic(d.Summary(), aggregatedWarningDetail(d.Detail(), paths, maxPaths)))
+	}
+
+	return result
+}
+
+// aggregatedWarningDetail returns the detail of an aggregated warning, which
+// lists up to maxPaths of the paths, in sorted order so the detail is
+// consistent, followed by the number of remaining paths.
+func aggregatedWarningDetail(detail string, paths path.Paths, maxPaths int) string {
+	var b strings.Builder
+
+	pathStrings := make([]string, 0, len(paths))
+
+	for _, p := range paths {
+		pathStrings = append(pathStrings, p.String())
+	}
+
+	sort.Strings(pathStrings)
+
+	if detail != "" {
+		b.WriteString(detail)
+		b.WriteString("\n\n")
+	}
+
+	if maxPaths < 1 {
+		fmt.Fprintf(&b, "This warning occurred at %d paths.", len(paths))
+
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "This warning occurred at %d paths:", len(paths))
+
+	for i, p := range pathStrings {
+		if i == maxPaths {
+			fmt.Fprintf(&b, "\nand %d more.", len(pathStrings)-maxPaths)
+
+			break
+		}
+
+		fmt.Fprintf(&b, "\n  - %s", p)
+	}
+
+	return b.String()
+}
+
+// pathHasPrefix returns true if the path is equal to or nested underneath the
+// prefix path.
+func pathHasPrefix(p path.Path, prefix path.Path) bool {
+	pSteps := p.Steps()
+	prefixSteps := prefix.Steps()
+
+	if len(prefixSteps) > len(pSteps) {
+		return false
+	}
+
+	return pSteps[:len(prefixSteps)].Equal(prefixSteps)
+}
@@ -822,3 +822,289 @@ func TestDiagnosticsWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestDiagnosticsFilter(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		keep     func(diag.Diagnostic) bool
+		expected diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags: nil,
+			keep: func(d diag.Diagnostic) bool {
+				return true
+			},
+			expected: diag.Diagnostics{},
+		},
+		"empty": {
+			diags: diag.Diagnostics{},
+			keep: func(d diag.Diagnostic) bool {
+				return true
+			},
+			expected: diag.Diagnostics{},
+		},
+		"keep-all": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			keep: func(d diag.Diagnostic) bool {
+				return true
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+		},
+		"keep-none": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			keep: func(d diag.Diagnostic) bool {
+				return false
+			},
+			expected: diag.Diagnostics{},
+		},
+		"summary": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Known Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Known Warning Summary", "Warning detail."),
+				diag.NewWarningDiagnostic("Other Warning Summary", "Warning detail."),
+			},
+			keep: func(d diag.Diagnostic) bool {
+				return d.Summary() != "Known Warning Summary"
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Other Warning Summary", "Warning detail."),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			original := make(diag.Diagnostics, len(test.diags))
+			copy(original, test.diags)
+
+			got := test.diags.Filter(test.keep)
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Fatalf("expected: %q, got: %q", test.expected, got)
+			}
+
+			if diff := cmp.Diff(original, test.diags); diff != "" {
+				t.Fatalf("unexpected modification: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsWithoutAttributePath(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		path     path.Path
+		expected diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			path:     path.Root("test"),
+			expected: diag.Diagnostics{},
+		},
+		"no-path": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			path: path.Root("test"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+		},
+		"equal-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("other"), "Warning Summary", "Warning detail."),
+			},
+			path: path.Root("test"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("other"), "Warning Summary", "Warning detail."),
+			},
+		},
+		"nested-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0).AtName("nested"), "Error Summary", "Error detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test").AtListIndex(1), "Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("testing"), "Warning Summary", "Warning detail."),
+			},
+			path: path.Root("test").AtListIndex(0),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test").AtListIndex(1), "Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("testing"), "Warning Summary", "Warning detail."),
+			},
+		},
+		"parent-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+			path: path.Root("test").AtName("nested"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			got := test.diags.WithoutAttributePath(test.path)
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Fatalf("expected: %q, got: %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsAggregateWarnings(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		maxPaths int
+		expected diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			maxPaths: 5,
+			expected: nil,
+		},
+		"single-warning": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Attribute Deprecated", "Use other instead."),
+			},
+			maxPaths: 5,
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Attribute Deprecated", "Use other instead."),
+			},
+		},
+		"identical-warnings": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_c"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_a"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_b").AtListIndex(0), "Attribute Deprecated", "Use other instead."),
+			},
+			maxPaths: 5,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Attribute Deprecated",
+					"Use other instead.\n\n"+
+						"This warning occurred at 3 paths:\n"+
+						"  - test_a\n"+
+						"  - test_b[0]\n"+
+						"  - test_c",
+				),
+			},
+		},
+		"identical-warnings-maxPaths-exceeded": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_a"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_b"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_c"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_d"), "Attribute Deprecated", "Use other instead."),
+			},
+			maxPaths: 2,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Attribute Deprecated",
+					"Use other instead.\n\n"+
+						"This warning occurred at 4 paths:\n"+
+						"  - test_a\n"+
+						"  - test_b\n"+
+						"and 2 more.",
+				),
+			},
+		},
+		"identical-warnings-maxPaths-zero": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_a"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_b"), "Attribute Deprecated", "Use other instead."),
+			},
+			maxPaths: 0,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Attribute Deprecated",
+					"Use other instead.\n\n"+
+						"This warning occurred at 2 paths.",
+				),
+			},
+		},
+		"different-warnings": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_a"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_b"), "Attribute Deprecated", "Use another instead."),
+			},
+			maxPaths: 5,
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_a"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_b"), "Attribute Deprecated", "Use another instead."),
+			},
+		},
+		"errors-and-no-path-warnings": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_a"), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test_b"), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			maxPaths: 5,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_a"), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test_b"), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+		},
+		"mixed": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_a"), "Error Summary", "Error detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_b"), "Attribute Deprecated", "Use other instead."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_c"), "Other Warning", "Other detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_d"), "Attribute Deprecated", "Use other instead."),
+			},
+			maxPaths: 5,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_a"), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic(
+					"Attribute Deprecated",
+					"Use other instead.\n\n"+
+						"This warning occurred at 2 paths:\n"+
+						"  - test_b\n"+
+						"  - test_d",
+				),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_c"), "Other Warning", "Other detail."),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.AggregateWarnings(test.maxPaths)
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
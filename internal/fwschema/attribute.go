@@ -2,6 +2,8 @@ package fwschema
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -98,5 +100,76 @@ func AttributesEqual(a, b Attribute) bool {
 		return false
 	}
 
+	aAliases := AttributeAliases(a)
+	bAliases := AttributeAliases(b)
+
+	if len(aAliases) != len(bAliases) {
+		return false
+	}
+
+	for i := range aAliases {
+		if aAliases[i] != bAliases[i] {
+			return false
+		}
+	}
+
 	return true
 }
+
+// AttributeValidateImplementation is a helper function which returns error
+// diagnostics if the attribute, or any attribute nested underneath it, is
+// defined with an invalid combination of Computed, Optional, and Required.
+// Terraform does not allow an attribute to be Required in combination with
+// either Computed or Optional. Attributes with aliases must be Optional and
+// nested alias names cannot conflict with other nested attribute names.
+func AttributeValidateImplementation(p path.Path, a Attribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if a.IsRequired() && a.IsComputed() {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Definition",
+			"Attribute cannot be both Required and Computed. Required attributes must be configured by practitioners, while Computed attributes without Optional are set by the provider. "+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+	}
+
+	if a.IsRequired() && a.IsOptional() {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Definition",
+			"Attribute cannot be both Required and Optional. Use only one of these fields to define whether a configuration value must be set. "+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+	}
+
+	if len(AttributeAliases(a)) > 0 && (!a.IsOptional() || a.IsRequired()) {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Definition",
+			"Attribute with Aliases must be Optional and cannot be Required. Practitioners may configure either the attribute or one of its aliases, "+
+				"so neither name can be enforced as required by Terraform. "+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+	}
+
+	nestedAttribute, ok := a.(NestedAttribute)
+
+	if !ok {
+		return diags
+	}
+
+	nestedObject := nestedAttribute.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	diags.Append(AttributesValidateAliases(p, nestedObject.GetAttributes(), nil)...)
+
+	for name, nestedAttr := range nestedObject.GetAttributes() {
+		diags.Append(AttributeValidateImplementation(p.AtName(name), nestedAttr)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,240 @@
+package fwschema
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeWithAliases is an optional interface on Attribute which enables
+// the attribute to also be configured under alternative, deprecated names.
+type AttributeWithAliases interface {
+	Attribute
+
+	// GetAliases should return the alternative attribute names, if any. This
+	// is named differently than Aliases to prevent a conflict with the
+	// attribute field name.
+	GetAliases() []string
+}
+
+// AttributeAliases is a helper function which returns the alias names of an
+// Attribute, if it implements AttributeWithAliases.
+func AttributeAliases(a Attribute) []string {
+	attributeWithAliases, ok := a.(AttributeWithAliases)
+
+	if !ok {
+		return nil
+	}
+
+	return attributeWithAliases.GetAliases()
+}
+
+// AttributesWithAliases is a helper function which returns the given
+// attributes along with an entry for every alias name. Alias entries are
+// Optional, not Computed or Required, and deprecated versions of the
+// attribute which declared the alias.
+//
+// The result should be used anywhere the schema must describe the data sent
+// by Terraform, such as type and protocol schema generation, while
+// framework logic which should only run against the attribute itself, such
+// as validation and plan modification, should use the given attributes.
+func AttributesWithAliases(attributes map[string]Attribute) map[string]Attribute {
+	var hasAliases bool
+
+	for _, attribute := range attributes {
+		if len(AttributeAliases(attribute)) > 0 {
+			hasAliases = true
+
+			break
+		}
+	}
+
+	if !hasAliases {
+		return attributes
+	}
+
+	result := make(map[string]Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		result[name] = attribute
+
+		for _, alias := range AttributeAliases(attribute) {
+			result[alias] = newAliasAttribute(name, attribute)
+		}
+	}
+
+	return result
+}
+
+// AttributeNamed is a helper function which returns the attribute with the
+// given name, or the alias attribute if the name is an alias of one of the
+// given attributes, without creating the AttributesWithAliases result.
+func AttributeNamed(attributes map[string]Attribute, name string) (Attribute, bool) {
+	if attribute, ok := attributes[name]; ok {
+		return attribute, true
+	}
+
+	for attributeName, attribute := range attributes {
+		for _, alias := range AttributeAliases(attribute) {
+			if alias == name {
+				return newAliasAttribute(attributeName, attribute), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// AttributeTypesWithAliases is a helper function which returns the types of
+// the given attributes along with an entry for every alias name, without
+// creating the AttributesWithAliases result.
+func AttributeTypesWithAliases(attributes map[string]Attribute) map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(attributes))
+
+	for name, attribute := range attributes {
+		attrType := attribute.GetType()
+		attrTypes[name] = attrType
+
+		for _, alias := range AttributeAliases(attribute) {
+			attrTypes[alias] = attrType
+		}
+	}
+
+	return attrTypes
+}
+
+// AttributesValidateAliases is a helper function which returns error
+// diagnostics if any alias name of the given attributes conflicts with
+// another attribute, alias, or block name at the same level of the schema.
+// The parent path should be the path of the object containing the
+// attributes, such as path.Empty() for the root of a schema.
+func AttributesValidateAliases(parent path.Path, attributes map[string]Attribute, blocks map[string]Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	// Sort names for consistent diagnostics ordering.
+	sort.Strings(names)
+
+	aliasOwners := make(map[string]string)
+
+	for _, name := range names {
+		for _, alias := range AttributeAliases(attributes[name]) {
+			_, attributeConflict := attributes[alias]
+			_, blockConflict := blocks[alias]
+			owner, aliasConflict := aliasOwners[alias]
+
+			switch {
+			case attributeConflict || blockConflict:
+				diags.AddAttributeError(
+					parent.AtName(name),
+					"Invalid Attribute Definition",
+					fmt.Sprintf("Attribute alias %q conflicts with an existing attribute or block of the same name. ", alias)+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				)
+			case aliasConflict:
+				diags.AddAttributeError(
+					parent.AtName(name),
+					"Invalid Attribute Definition",
+					fmt.Sprintf("Attribute alias %q is already declared by the %s attribute. ", alias, parent.AtName(owner))+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				)
+			default:
+				aliasOwners[alias] = name
+			}
+		}
+	}
+
+	return diags
+}
+
+// newAliasAttribute returns the Attribute which represents an alias of the
+// given attribute, preserving any nested attribute implementation.
+func newAliasAttribute(name string, a Attribute) Attribute {
+	alias := aliasAttribute{
+		Attribute: a,
+		name:      name,
+	}
+
+	if nestedAttribute, ok := a.(NestedAttribute); ok {
+		return aliasNestedAttribute{
+			aliasAttribute:  alias,
+			nestedAttribute: nestedAttribute,
+		}
+	}
+
+	return alias
+}
+
+// aliasAttribute is the Attribute for an alias name. The underlying attribute
+// only supplies the type, descriptions, sensitivity, and path handling.
+// Validators and plan modifiers of the underlying attribute are intentionally
+// not exposed.
+type aliasAttribute struct {
+	Attribute
+
+	// name is the attribute name which declared the alias.
+	name string
+}
+
+// Equal returns true if the given Attribute is an equivalent alias.
+func (a aliasAttribute) Equal(o Attribute) bool {
+	var other aliasAttribute
+
+	switch o := o.(type) {
+	case aliasAttribute:
+		other = o
+	case aliasNestedAttribute:
+		other = o.aliasAttribute
+	default:
+		return false
+	}
+
+	return a.name == other.name && a.Attribute.Equal(other.Attribute)
+}
+
+// GetDeprecationMessage returns a message directing practitioners to the
+// attribute which declared the alias.
+func (a aliasAttribute) GetDeprecationMessage() string {
+	return fmt.Sprintf("Configure %s instead. This attribute name is a deprecated alias.", a.name)
+}
+
+// IsComputed always returns false, as alias values cannot be set by the
+// provider.
+func (a aliasAttribute) IsComputed() bool {
+	return false
+}
+
+// IsOptional always returns true.
+func (a aliasAttribute) IsOptional() bool {
+	return true
+}
+
+// IsRequired always returns false.
+func (a aliasAttribute) IsRequired() bool {
+	return false
+}
+
+// aliasNestedAttribute is the NestedAttribute for an alias name.
+type aliasNestedAttribute struct {
+	aliasAttribute
+
+	nestedAttribute NestedAttribute
+}
+
+// GetNestedObject returns the underlying nested attribute object.
+func (a aliasNestedAttribute) GetNestedObject() NestedAttributeObject {
+	return a.nestedAttribute.GetNestedObject()
+}
+
+// GetNestingMode returns the underlying nested attribute nesting mode.
+func (a aliasNestedAttribute) GetNestingMode() NestingMode {
+	return a.nestedAttribute.GetNestingMode()
+}
@@ -2,6 +2,8 @@ package fwschema
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -80,3 +82,29 @@ func BlocksEqual(a, b Block) bool {
 
 	return true
 }
+
+// BlockValidateImplementation is a helper function which returns error
+// diagnostics if any attribute nested underneath the block is defined with an
+// invalid combination of Computed, Optional, and Required, or with
+// conflicting alias names.
+func BlockValidateImplementation(p path.Path, b Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nestedObject := b.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	diags.Append(AttributesValidateAliases(p, nestedObject.GetAttributes(), nestedObject.GetBlocks())...)
+
+	for name, attribute := range nestedObject.GetAttributes() {
+		diags.Append(AttributeValidateImplementation(p.AtName(name), attribute)...)
+	}
+
+	for name, block := range nestedObject.GetBlocks() {
+		diags.Append(BlockValidateImplementation(p.AtName(name), block)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,187 @@
+package fwxschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SchemaValidatorDescriptions returns the Description of every validator
+// declared in the schema, keyed by the path expression string of the
+// attribute, block, or nested object declaring them, such as
+// "list_attribute[*].nested_attribute". Validators of nested attribute and
+// block objects use the path expression of the object, such as
+// "list_attribute[*]". Paths without validators are omitted. Validators are
+// not called, so this is suitable for documentation and linting tooling.
+func SchemaValidatorDescriptions(ctx context.Context, s fwschema.Schema) map[string][]string {
+	result := make(map[string][]string)
+
+	attributesValidatorDescriptions(ctx, s.GetAttributes(), path.Empty().Expression(), result)
+	blocksValidatorDescriptions(ctx, s.GetBlocks(), path.Empty().Expression(), result)
+
+	return result
+}
+
+// attributesValidatorDescriptions adds the validator descriptions of the
+// given attributes, and any attributes nested underneath them, to result.
+func attributesValidatorDescriptions(ctx context.Context, attributes map[string]fwschema.Attribute, parentExpression path.Expression, result map[string][]string) {
+	for name, a := range attributes {
+		expression := parentExpression.AtName(name)
+
+		addValidatorDescriptions(ctx, expression, AttributeValidators(a), result)
+
+		nestedAttribute, ok := a.(fwschema.NestedAttribute)
+
+		if !ok {
+			continue
+		}
+
+		switch nestedAttribute.GetNestingMode() {
+		case fwschema.NestingModeList:
+			expression = expression.AtAnyListIndex()
+		case fwschema.NestingModeMap:
+			expression = expression.AtAnyMapKey()
+		case fwschema.NestingModeSet:
+			expression = expression.AtAnySetValue()
+		}
+
+		nestedObject := nestedAttribute.GetNestedObject()
+
+		// Single nested objects share the validators of the attribute, which
+		// were already added under the same path expression.
+		nestedObjectWithValidators, ok := nestedObject.(NestedAttributeObjectWithValidators)
+
+		if ok && nestedAttribute.GetNestingMode() != fwschema.NestingModeSingle {
+			addValidatorDescriptions(ctx, expression, objectValidatorDescribers(nestedObjectWithValidators.ObjectValidators()), result)
+		}
+
+		attributesValidatorDescriptions(ctx, nestedObject.GetAttributes(), expression, result)
+	}
+}
+
+// blocksValidatorDescriptions adds the validator descriptions of the given
+// blocks, and any attributes and blocks nested underneath them, to result.
+func blocksValidatorDescriptions(ctx context.Context, blocks map[string]fwschema.Block, parentExpression path.Expression, result map[string][]string) {
+	for name, b := range blocks {
+		expression := parentExpression.AtName(name)
+
+		addValidatorDescriptions(ctx, expression, BlockValidators(b), result)
+
+		switch b.GetNestingMode() {
+		case fwschema.BlockNestingModeList:
+			expression = expression.AtAnyListIndex()
+		case fwschema.BlockNestingModeSet:
+			expression = expression.AtAnySetValue()
+		}
+
+		nestedObject := b.GetNestedObject()
+
+		// Single nested objects share the validators of the block, which
+		// were already added under the same path expression.
+		nestedObjectWithValidators, ok := nestedObject.(NestedBlockObjectWithValidators)
+
+		if ok && b.GetNestingMode() != fwschema.BlockNestingModeSingle {
+			addValidatorDescriptions(ctx, expression, objectValidatorDescribers(nestedObjectWithValidators.ObjectValidators()), result)
+		}
+
+		attributesValidatorDescriptions(ctx, nestedObject.GetAttributes(), expression, result)
+		blocksValidatorDescriptions(ctx, nestedObject.GetBlocks(), expression, result)
+	}
+}
+
+// addValidatorDescriptions adds the descriptions of the validators to result
+// under the path expression string, if there are any validators.
+func addValidatorDescriptions(ctx context.Context, expression path.Expression, describers []validator.Describer, result map[string][]string) {
+	if len(describers) == 0 {
+		return
+	}
+
+	key := expression.String()
+
+	for _, describer := range describers {
+		result[key] = append(result[key], describer.Description(ctx))
+	}
+}
+
+// objectValidatorDescribers returns the Object validators as Describers.
+func objectValidatorDescribers(validators []validator.Object) []validator.Describer {
+	describers := make([]validator.Describer, 0, len(validators))
+
+	for _, v := range validators {
+		describers = append(describers, v)
+	}
+
+	return describers
+}
+
+// AttributeValidators returns the validators of the Attribute type,
+// such as String validators for a StringAttribute.
+func AttributeValidators(a fwschema.Attribute) []validator.Describer {
+	var describers []validator.Describer
+
+	switch a := a.(type) {
+	case AttributeWithBoolValidators:
+		for _, v := range a.BoolValidators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithFloat64Validators:
+		for _, v := range a.Float64Validators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithInt64Validators:
+		for _, v := range a.Int64Validators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithListValidators:
+		for _, v := range a.ListValidators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithMapValidators:
+		for _, v := range a.MapValidators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithNumberValidators:
+		for _, v := range a.NumberValidators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithObjectValidators:
+		for _, v := range a.ObjectValidators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithSetValidators:
+		for _, v := range a.SetValidators() {
+			describers = append(describers, v)
+		}
+	case AttributeWithStringValidators:
+		for _, v := range a.StringValidators() {
+			describers = append(describers, v)
+		}
+	}
+
+	return describers
+}
+
+// BlockValidators returns the validators of the Block type, such as
+// List validators for a ListNestedBlock.
+func BlockValidators(b fwschema.Block) []validator.Describer {
+	var describers []validator.Describer
+
+	switch b := b.(type) {
+	case BlockWithListValidators:
+		for _, v := range b.ListValidators() {
+			describers = append(describers, v)
+		}
+	case BlockWithObjectValidators:
+		for _, v := range b.ObjectValidators() {
+			describers = append(describers, v)
+		}
+	case BlockWithSetValidators:
+		for _, v := range b.SetValidators() {
+			describers = append(describers, v)
+		}
+	}
+
+	return describers
+}
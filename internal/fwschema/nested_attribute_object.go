@@ -1,9 +1,12 @@
 package fwschema
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -36,7 +39,7 @@ func NestedAttributeObjectApplyTerraform5AttributePathStep(o NestedAttributeObje
 		return nil, fmt.Errorf("cannot apply AttributePathStep %T to NestedAttributeObject", step)
 	}
 
-	attribute, ok := o.GetAttributes()[string(name)]
+	attribute, ok := AttributeNamed(o.GetAttributes(), string(name))
 
 	if ok {
 		return attribute, nil
@@ -78,13 +81,92 @@ func NestedAttributeObjectEqual(a, b NestedAttributeObject) bool {
 // implementations should still include custom type functionality in addition
 // to using this helper.
 func NestedAttributeObjectType(o NestedAttributeObject) basetypes.ObjectTypable {
-	attrTypes := make(map[string]attr.Type, len(o.GetAttributes()))
+	return types.ObjectType{
+		AttrTypes: AttributeTypesWithAliases(o.GetAttributes()),
+	}
+}
 
-	for name, attribute := range o.GetAttributes() {
-		attrTypes[name] = attribute.GetType()
+// NestedAttributeObjectTypeValidate is a helper function which returns
+// diagnostics if the attribute types of the given object type are not
+// consistent with the given underlying attributes, such as a CustomType
+// which is missing an attribute type, includes an extra attribute type, or
+// defines a different attribute type than the attribute definition. Any
+// attribute alias names must also be present in the object type.
+func NestedAttributeObjectTypeValidate(typ basetypes.ObjectTypable, underlyingAttributes UnderlyingAttributes) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeWithAttributeTypes, ok := typ.(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		diags.AddError(
+			"Invalid Nested Attribute Object Type",
+			"While creating a nested attribute object, an object type without attribute type information was detected. "+
+				"The object type must implement the attr.TypeWithAttributeTypes interface. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Object Type: %T", typ),
+		)
+
+		return diags
 	}
 
-	return types.ObjectType{
-		AttrTypes: attrTypes,
+	// Differences are reported from the perspective of the attribute
+	// definitions, so nested object and collection element types are
+	// compared recursively.
+	definitionType := basetypes.ObjectType{
+		AttrTypes: AttributeTypesWithAliases(underlyingAttributes),
+	}
+
+	mismatches := definitionType.Diff(basetypes.ObjectType{
+		AttrTypes: typeWithAttributeTypes.AttributeTypes(),
+	})
+
+	if len(mismatches) > 0 {
+		diags.AddError(
+			"Invalid Nested Attribute Object Type",
+			"While creating a nested attribute object, the object type was detected as inconsistent with the attribute definitions. "+
+				"The object type must define exactly the same attribute names and types as the attribute definitions. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				strings.Join(mismatches, "\n"),
+		)
+	}
+
+	return diags
+}
+
+// NestedAttributeObjectNullValue is a helper function which returns the null
+// value of the NestedAttributeObject type, which is the ObjectValuable of any
+// custom type.
+func NestedAttributeObjectNullValue(ctx context.Context, o NestedAttributeObject) (attr.Value, diag.Diagnostics) {
+	return nestedAttributeObjectValue(ctx, o, nil)
+}
+
+// NestedAttributeObjectUnknownValue is a helper function which returns the
+// unknown value of the NestedAttributeObject type, which is the ObjectValuable
+// of any custom type.
+func NestedAttributeObjectUnknownValue(ctx context.Context, o NestedAttributeObject) (attr.Value, diag.Diagnostics) {
+	return nestedAttributeObjectValue(ctx, o, tftypes.UnknownValue)
+}
+
+// nestedAttributeObjectValue returns the value of the NestedAttributeObject
+// type for the given null or unknown Terraform value.
+func nestedAttributeObjectValue(ctx context.Context, o NestedAttributeObject, value any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	typ := o.Type()
+
+	result, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), value))
+
+	if err != nil {
+		diags.AddError(
+			"Nested Attribute Object Value Creation Error",
+			"An unexpected error was encountered while creating a nested attribute object value. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Object Type: %T\n", typ)+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
 	}
+
+	return result, diags
 }
@@ -3,7 +3,6 @@ package fwschema
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -39,7 +38,7 @@ func NestedBlockObjectApplyTerraform5AttributePathStep(o NestedBlockObject, step
 		return nil, fmt.Errorf("cannot apply AttributePathStep %T to NestedBlockObject", step)
 	}
 
-	attribute, ok := o.GetAttributes()[string(name)]
+	attribute, ok := AttributeNamed(o.GetAttributes(), string(name))
 
 	if ok {
 		return attribute, nil
@@ -103,11 +102,7 @@ func NestedBlockObjectEqual(a, b NestedBlockObject) bool {
 // implementations should still include custom type functionality in addition
 // to using this helper.
 func NestedBlockObjectType(o NestedBlockObject) basetypes.ObjectTypable {
-	attrTypes := make(map[string]attr.Type, len(o.GetAttributes())+len(o.GetBlocks()))
-
-	for name, attribute := range o.GetAttributes() {
-		attrTypes[name] = attribute.GetType()
-	}
+	attrTypes := AttributeTypesWithAliases(o.GetAttributes())
 
 	for name, block := range o.GetBlocks() {
 		attrTypes[name] = block.Type()
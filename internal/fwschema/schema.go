@@ -81,7 +81,7 @@ func SchemaApplyTerraform5AttributePathStep(s Schema, step tftypes.AttributePath
 		return nil, fmt.Errorf("cannot apply AttributePathStep %T to schema", step)
 	}
 
-	if attr, ok := s.GetAttributes()[string(name)]; ok {
+	if attr, ok := AttributeNamed(s.GetAttributes(), string(name)); ok {
 		return attr, nil
 	}
 
@@ -211,11 +211,7 @@ func SchemaTypeAtTerraformPath(ctx context.Context, s Schema, p *tftypes.Attribu
 // SchemaType is a helper function to perform base type handling using the
 // GetAttributes and GetBlocks methods.
 func SchemaType(s Schema) attr.Type {
-	attrTypes := map[string]attr.Type{}
-
-	for name, attr := range s.GetAttributes() {
-		attrTypes[name] = attr.GetType()
-	}
+	attrTypes := AttributeTypesWithAliases(s.GetAttributes())
 
 	for name, block := range s.GetBlocks() {
 		attrTypes[name] = block.Type()
@@ -223,3 +219,27 @@ func SchemaType(s Schema) attr.Type {
 
 	return types.ObjectType{AttrTypes: attrTypes}
 }
+
+// SchemaValidateImplementation is a helper function which returns error
+// diagnostics if any attribute within the schema, including attributes nested
+// underneath attributes and blocks, is defined with an invalid combination of
+// Computed, Optional, and Required, or with conflicting alias names.
+//
+// The protocol version is not known here, so protocol version specific
+// constraints, such as protocol version 5 not supporting nested attributes
+// within blocks, are checked when the schema is converted for the protocol.
+func SchemaValidateImplementation(s Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(AttributesValidateAliases(path.Empty(), s.GetAttributes(), s.GetBlocks())...)
+
+	for name, attribute := range s.GetAttributes() {
+		diags.Append(AttributeValidateImplementation(path.Root(name), attribute)...)
+	}
+
+	for name, block := range s.GetBlocks() {
+		diags.Append(BlockValidateImplementation(path.Root(name), block)...)
+	}
+
+	return diags
+}
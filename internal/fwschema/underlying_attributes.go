@@ -3,7 +3,6 @@ package fwschema
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -23,7 +22,7 @@ func (u UnderlyingAttributes) ApplyTerraform5AttributePathStep(step tftypes.Attr
 		return nil, fmt.Errorf("can't apply %T to Attributes", step)
 	}
 
-	attribute, ok := u[string(name)]
+	attribute, ok := AttributeNamed(u, string(name))
 
 	if !ok {
 		return nil, fmt.Errorf("no attribute %q on Attributes", name)
@@ -55,13 +54,7 @@ func (u UnderlyingAttributes) Equal(o UnderlyingAttributes) bool {
 
 // Type returns the framework type of the underlying attributes.
 func (u UnderlyingAttributes) Type() basetypes.ObjectTypable {
-	attrTypes := make(map[string]attr.Type, len(u))
-
-	for name, attr := range u {
-		attrTypes[name] = attr.GetType()
-	}
-
 	return basetypes.ObjectType{
-		AttrTypes: attrTypes,
+		AttrTypes: AttributeTypesWithAliases(u),
 	}
 }
@@ -0,0 +1,599 @@
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// aliasesObject contains the attributes and blocks of an object within the
+// schema. It is used to convert values between the data, where attribute
+// aliases are separate attributes, and provider data models, which only
+// include the attribute names which declared the aliases.
+type aliasesObject struct {
+	attributes map[string]fwschema.Attribute
+	blocks     map[string]fwschema.Block
+}
+
+// newAliasesObject returns the aliasesObject of the given schema, attribute,
+// block, or nested object, along with whether the value is a collection of
+// objects. It returns false if there is no object, such as a non-nested
+// attribute.
+func newAliasesObject(element any) (aliasesObject, bool, bool) {
+	switch element := element.(type) {
+	case fwschema.Schema:
+		return aliasesObject{
+			attributes: element.GetAttributes(),
+			blocks:     element.GetBlocks(),
+		}, false, true
+	case fwschema.NestedAttribute:
+		return aliasesObject{
+			attributes: element.GetNestedObject().GetAttributes(),
+		}, element.GetNestingMode() != fwschema.NestingModeSingle, true
+	case fwschema.Block:
+		return aliasesObject{
+			attributes: element.GetNestedObject().GetAttributes(),
+			blocks:     element.GetNestedObject().GetBlocks(),
+		}, element.GetNestingMode() != fwschema.BlockNestingModeSingle, true
+	case fwschema.NestedBlockObject:
+		return aliasesObject{
+			attributes: element.GetAttributes(),
+			blocks:     element.GetBlocks(),
+		}, false, true
+	case fwschema.NestedAttributeObject:
+		return aliasesObject{
+			attributes: element.GetAttributes(),
+		}, false, true
+	default:
+		return aliasesObject{}, false, false
+	}
+}
+
+// nested returns the aliasesObject of the attribute, alias, or block with the
+// given name, along with whether the value is a collection of objects.
+func (o aliasesObject) nested(name string) (aliasesObject, bool, bool) {
+	if attribute, ok := fwschema.AttributeNamed(o.attributes, name); ok {
+		return newAliasesObject(attribute)
+	}
+
+	if block, ok := o.blocks[name]; ok {
+		return newAliasesObject(block)
+	}
+
+	return aliasesObject{}, false, false
+}
+
+// hasAliases returns true if any attribute of the object, including nested
+// attributes, declares aliases.
+func (o aliasesObject) hasAliases() bool {
+	for name, attribute := range o.attributes {
+		if len(fwschema.AttributeAliases(attribute)) > 0 {
+			return true
+		}
+
+		if nested, _, ok := o.nested(name); ok && nested.hasAliases() {
+			return true
+		}
+	}
+
+	for name := range o.blocks {
+		if nested, _, ok := o.nested(name); ok && nested.hasAliases() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withoutAliasesType returns the given type without the attribute types of
+// alias names, including any nested alias names.
+func (o aliasesObject) withoutAliasesType(typ attr.Type, collection bool) attr.Type {
+	if collection {
+		typWithElementType, ok := typ.(attr.TypeWithElementType)
+
+		if !ok {
+			return typ
+		}
+
+		return typWithElementType.WithElementType(o.withoutAliasesType(typWithElementType.ElementType(), false))
+	}
+
+	typWithAttributeTypes, ok := typ.(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		return typ
+	}
+
+	attrTypes := make(map[string]attr.Type, len(o.attributes)+len(o.blocks))
+
+	for name, attrType := range typWithAttributeTypes.AttributeTypes() {
+		_, isAttribute := o.attributes[name]
+		_, isBlock := o.blocks[name]
+
+		// Any other name is an alias.
+		if !isAttribute && !isBlock {
+			continue
+		}
+
+		if nested, collection, ok := o.nested(name); ok {
+			attrType = nested.withoutAliasesType(attrType, collection)
+		}
+
+		attrTypes[name] = attrType
+	}
+
+	return typWithAttributeTypes.WithAttributeTypes(attrTypes)
+}
+
+// withoutAliasesValue returns the given value with the given type, which
+// should be from withoutAliasesType. The value of any configured alias is
+// copied into the null attribute which declared the alias.
+func (o aliasesObject) withoutAliasesValue(val tftypes.Value, typ tftypes.Type, collection bool) (tftypes.Value, error) {
+	if val.Type().Equal(typ) {
+		return val, nil
+	}
+
+	if !val.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if val.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if collection {
+		return collectionValue(val, typ, func(elem tftypes.Value, elemType tftypes.Type) (tftypes.Value, error) {
+			return o.withoutAliasesValue(elem, elemType, false)
+		})
+	}
+
+	objectType, ok := typ.(tftypes.Object)
+
+	if !ok {
+		return val, fmt.Errorf("cannot remove attribute aliases from %s value with %s type", val.Type(), typ)
+	}
+
+	var vals map[string]tftypes.Value
+
+	if err := val.As(&vals); err != nil {
+		return val, err
+	}
+
+	result := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attrType := range objectType.AttributeTypes {
+		attrVal := vals[name]
+
+		if attrVal.IsNull() {
+			for _, alias := range fwschema.AttributeAliases(o.attributes[name]) {
+				if aliasVal, ok := vals[alias]; ok && !aliasVal.IsNull() {
+					attrVal = aliasVal
+
+					break
+				}
+			}
+		}
+
+		if nested, collection, ok := o.nested(name); ok {
+			var err error
+
+			attrVal, err = nested.withoutAliasesValue(attrVal, attrType, collection)
+
+			if err != nil {
+				return val, err
+			}
+		}
+
+		result[name] = attrVal
+	}
+
+	return tftypes.NewValue(objectType, result), nil
+}
+
+// addAliasesValue returns the given value with the given type, which should
+// include alias attribute types. Alias values are null.
+func addAliasesValue(val tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if val.Type().Equal(typ) {
+		return val, nil
+	}
+
+	if !val.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if val.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	objectType, ok := typ.(tftypes.Object)
+
+	if !ok {
+		return collectionValue(val, typ, addAliasesValue)
+	}
+
+	var vals map[string]tftypes.Value
+
+	if err := val.As(&vals); err != nil {
+		return val, err
+	}
+
+	result := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attrType := range objectType.AttributeTypes {
+		attrVal, ok := vals[name]
+
+		if !ok {
+			result[name] = tftypes.NewValue(attrType, nil)
+
+			continue
+		}
+
+		attrVal, err := addAliasesValue(attrVal, attrType)
+
+		if err != nil {
+			return val, err
+		}
+
+		result[name] = attrVal
+	}
+
+	return tftypes.NewValue(objectType, result), nil
+}
+
+// restoreAliasesValue returns the given value with the value of any attribute
+// moved into the alias which is configured in the reference value, if the
+// alias is null in the given value. Set elements are matched with the
+// reference element which is equal after moving the values.
+func (o aliasesObject) restoreAliasesValue(val tftypes.Value, reference tftypes.Value, collection bool) (tftypes.Value, error) {
+	if !val.IsKnown() || val.IsNull() || !reference.IsKnown() || reference.IsNull() || !val.Type().Equal(reference.Type()) {
+		return val, nil
+	}
+
+	if collection {
+		return o.restoreAliasesCollectionValue(val, reference)
+	}
+
+	var valVals, referenceVals map[string]tftypes.Value
+
+	if err := val.As(&valVals); err != nil {
+		return val, err
+	}
+
+	if err := reference.As(&referenceVals); err != nil {
+		return val, err
+	}
+
+	// The underlying map of the value is shared, so changes are made to a copy.
+	vals := make(map[string]tftypes.Value, len(valVals))
+
+	for name, attrVal := range valVals {
+		vals[name] = attrVal
+	}
+
+	for name, attribute := range o.attributes {
+		if !referenceVals[name].IsNull() {
+			continue
+		}
+
+		for _, alias := range fwschema.AttributeAliases(attribute) {
+			if referenceVals[alias].IsNull() || !vals[alias].IsNull() {
+				continue
+			}
+
+			vals[alias] = vals[name]
+			vals[name] = tftypes.NewValue(vals[name].Type(), nil)
+
+			break
+		}
+	}
+
+	for name, attrVal := range vals {
+		nested, collection, ok := o.nested(name)
+
+		if !ok || !nested.hasAliases() {
+			continue
+		}
+
+		var err error
+
+		vals[name], err = nested.restoreAliasesValue(attrVal, referenceVals[name], collection)
+
+		if err != nil {
+			return val, err
+		}
+	}
+
+	return tftypes.NewValue(val.Type(), vals), nil
+}
+
+// restoreAliasesCollectionValue performs restoreAliasesValue on each object
+// of a list, map, or set value.
+func (o aliasesObject) restoreAliasesCollectionValue(val tftypes.Value, reference tftypes.Value) (tftypes.Value, error) {
+	switch val.Type().(type) {
+	case tftypes.List:
+		var valElems, referenceElems []tftypes.Value
+
+		if err := val.As(&valElems); err != nil {
+			return val, err
+		}
+
+		if err := reference.As(&referenceElems); err != nil {
+			return val, err
+		}
+
+		elems := append([]tftypes.Value(nil), valElems...)
+
+		for idx := 0; idx < len(elems) && idx < len(referenceElems); idx++ {
+			restored, err := o.restoreAliasesValue(elems[idx], referenceElems[idx], false)
+
+			if err != nil {
+				return val, err
+			}
+
+			elems[idx] = restored
+		}
+
+		return tftypes.NewValue(val.Type(), elems), nil
+	case tftypes.Set:
+		var valElems, referenceElems []tftypes.Value
+
+		if err := val.As(&valElems); err != nil {
+			return val, err
+		}
+
+		if err := reference.As(&referenceElems); err != nil {
+			return val, err
+		}
+
+		elems := append([]tftypes.Value(nil), valElems...)
+
+		for idx, elem := range elems {
+			for _, referenceElem := range referenceElems {
+				restored, err := o.restoreAliasesValue(elem, referenceElem, false)
+
+				if err != nil {
+					return val, err
+				}
+
+				if restored.Equal(referenceElem) {
+					elems[idx] = restored
+
+					break
+				}
+			}
+		}
+
+		return tftypes.NewValue(val.Type(), elems), nil
+	case tftypes.Map:
+		var valElems, referenceElems map[string]tftypes.Value
+
+		if err := val.As(&valElems); err != nil {
+			return val, err
+		}
+
+		if err := reference.As(&referenceElems); err != nil {
+			return val, err
+		}
+
+		elems := make(map[string]tftypes.Value, len(valElems))
+
+		for key, elem := range valElems {
+			elems[key] = elem
+		}
+
+		for key, elem := range valElems {
+			referenceElem, ok := referenceElems[key]
+
+			if !ok {
+				continue
+			}
+
+			restored, err := o.restoreAliasesValue(elem, referenceElem, false)
+
+			if err != nil {
+				return val, err
+			}
+
+			elems[key] = restored
+		}
+
+		return tftypes.NewValue(val.Type(), elems), nil
+	default:
+		return val, fmt.Errorf("cannot restore attribute aliases of %s value", val.Type())
+	}
+}
+
+// collectionValue returns the list, map, or set value with the given type,
+// using the given function to convert each element.
+func collectionValue(val tftypes.Value, typ tftypes.Type, convert func(tftypes.Value, tftypes.Type) (tftypes.Value, error)) (tftypes.Value, error) {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		elems, err := collectionElements(val, typ.ElementType, convert)
+
+		if err != nil {
+			return val, err
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Set:
+		elems, err := collectionElements(val, typ.ElementType, convert)
+
+		if err != nil {
+			return val, err
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Map:
+		var valElems map[string]tftypes.Value
+
+		if err := val.As(&valElems); err != nil {
+			return val, err
+		}
+
+		elems := make(map[string]tftypes.Value, len(valElems))
+
+		for key, elem := range valElems {
+			var err error
+
+			elems[key], err = convert(elem, typ.ElementType)
+
+			if err != nil {
+				return val, err
+			}
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	default:
+		return val, fmt.Errorf("cannot convert %s value to %s type", val.Type(), typ)
+	}
+}
+
+// collectionElements returns the converted elements of a list or set value.
+func collectionElements(val tftypes.Value, elemType tftypes.Type, convert func(tftypes.Value, tftypes.Type) (tftypes.Value, error)) ([]tftypes.Value, error) {
+	var valElems []tftypes.Value
+
+	if err := val.As(&valElems); err != nil {
+		return nil, err
+	}
+
+	elems := make([]tftypes.Value, len(valElems))
+
+	for idx, elem := range valElems {
+		var err error
+
+		elems[idx], err = convert(elem, elemType)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return elems, nil
+}
+
+// aliasesObjectAtPath returns the aliasesObject of the value at the given
+// path, along with whether the value is a collection of objects. It returns
+// false if the schema has no attribute aliases at or underneath the path.
+func (d Data) aliasesObjectAtPath(ctx context.Context, schemaPath path.Path) (aliasesObject, bool, bool) {
+	tftypesPath, diags := totftypes.AttributePath(ctx, schemaPath)
+
+	if diags.HasError() {
+		return aliasesObject{}, false, false
+	}
+
+	element, _, err := tftypes.WalkAttributePath(d.Schema, tftypesPath)
+
+	if err != nil {
+		return aliasesObject{}, false, false
+	}
+
+	object, collection, ok := newAliasesObject(element)
+
+	if !ok || !object.hasAliases() {
+		return aliasesObject{}, false, false
+	}
+
+	return object, collection, true
+}
+
+// valueWithoutAliases returns the value at the given path as provider data
+// models expect it, where alias values are only available under the
+// attribute name which declared the alias. If the path is an attribute which
+// declares aliases and is null, the value of a configured alias is returned.
+func (d Data) valueWithoutAliases(ctx context.Context, schemaPath path.Path, attrValue attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if attrValue.IsNull() {
+		if attribute, attributeDiags := d.Schema.AttributeAtPath(ctx, schemaPath); !attributeDiags.HasError() {
+			for _, alias := range fwschema.AttributeAliases(attribute) {
+				aliasValue, aliasDiags := d.ValueAtPath(ctx, schemaPath.ParentPath().AtName(alias))
+
+				diags.Append(aliasDiags...)
+
+				if aliasDiags.HasError() {
+					return attrValue, diags
+				}
+
+				if !aliasValue.IsNull() {
+					attrValue = aliasValue
+
+					break
+				}
+			}
+		}
+	}
+
+	object, collection, ok := d.aliasesObjectAtPath(ctx, schemaPath)
+
+	if !ok {
+		return attrValue, diags
+	}
+
+	tfValue, err := attrValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: Cannot run ToTerraformValue on attribute value: "+err.Error(),
+		)
+		return attrValue, diags
+	}
+
+	attrType := object.withoutAliasesType(attrValue.Type(ctx), collection)
+
+	tfValue, err = object.withoutAliasesValue(tfValue, attrType.TerraformType(ctx), collection)
+
+	if err == nil {
+		attrValue, err = attrType.ValueFromTerraform(ctx, tfValue)
+	}
+
+	if err != nil {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: Cannot remove attribute aliases: "+err.Error(),
+		)
+		return attrValue, diags
+	}
+
+	return attrValue, diags
+}
+
+// RestoreAliases moves the value of each attribute into the alias which is
+// configured in the given reference value, such as the configuration, if
+// the attribute is null in the reference value. Provider data models only
+// include the attribute names which declared the aliases, so this ensures
+// responses to Terraform keep the values under the configured alias names.
+func (d *Data) RestoreAliases(ctx context.Context, reference tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	object, _, ok := newAliasesObject(d.Schema)
+
+	if !ok || !object.hasAliases() {
+		return diags
+	}
+
+	tfValue, err := object.restoreAliasesValue(d.TerraformValue, reference, false)
+
+	if err != nil {
+		diags.AddError(
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: Cannot restore attribute aliases: "+err.Error(),
+		)
+		return diags
+	}
+
+	d.TerraformValue = tfValue
+
+	return diags
+}
@@ -0,0 +1,195 @@
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testAliasesSchema returns a schema with a name attribute and a list nested
+// attribute with a value attribute, each with an alias.
+func testAliasesSchema(nestingMode fwschema.NestingMode) fwschema.Schema {
+	return testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"name": testschema.Attribute{
+				Aliases:  []string{"old_name"},
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"list": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"value": testschema.Attribute{
+							Aliases:  []string{"old_value"},
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: nestingMode,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// testAliasesElementType is the type of testAliasesSchema nested objects.
+var testAliasesElementType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"old_value": tftypes.String,
+		"value":     tftypes.String,
+	},
+}
+
+// testAliasesValue returns a value of testAliasesSchema with the given name
+// attribute values and nested object value attribute values.
+func testAliasesValue(collectionType tftypes.Type, name, oldName interface{}, values ...[2]interface{}) tftypes.Value {
+	elems := make([]tftypes.Value, 0, len(values))
+
+	for _, value := range values {
+		elems = append(elems, tftypes.NewValue(testAliasesElementType, map[string]tftypes.Value{
+			"old_value": tftypes.NewValue(tftypes.String, value[1]),
+			"value":     tftypes.NewValue(tftypes.String, value[0]),
+		}))
+	}
+
+	return tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list":     collectionType,
+				"name":     tftypes.String,
+				"old_name": tftypes.String,
+			},
+		},
+		map[string]tftypes.Value{
+			"list":     tftypes.NewValue(collectionType, elems),
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"old_name": tftypes.NewValue(tftypes.String, oldName),
+		},
+	)
+}
+
+// testAliasesModel is a data model of testAliasesSchema, which does not
+// include the alias names.
+type testAliasesModel struct {
+	List []testAliasesElementModel `tfsdk:"list"`
+	Name types.String              `tfsdk:"name"`
+}
+
+// testAliasesElementModel is a data model of testAliasesSchema nested
+// objects, which does not include the alias names.
+type testAliasesElementModel struct {
+	Value types.String `tfsdk:"value"`
+}
+
+func TestDataRestoreAliases(t *testing.T) {
+	t.Parallel()
+
+	listType := tftypes.List{ElementType: testAliasesElementType}
+	setType := tftypes.Set{ElementType: testAliasesElementType}
+
+	testCases := map[string]struct {
+		data          fwschemadata.Data
+		reference     tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"aliases-configured": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(listType, "test-name", nil, [2]interface{}{"test-value", nil}, [2]interface{}{"test-value", nil}),
+			},
+			reference: testAliasesValue(listType, nil, "test-name", [2]interface{}{nil, "test-value"}, [2]interface{}{"test-value", nil}),
+			expected:  testAliasesValue(listType, nil, "test-name", [2]interface{}{nil, "test-value"}, [2]interface{}{"test-value", nil}),
+		},
+		"aliases-configured-changed-value": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(listType, "new-name", nil, [2]interface{}{"new-value", nil}),
+			},
+			reference: testAliasesValue(listType, nil, "test-name", [2]interface{}{nil, "test-value"}),
+			expected:  testAliasesValue(listType, nil, "new-name", [2]interface{}{nil, "new-value"}),
+		},
+		"aliases-configured-set": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeSet),
+				TerraformValue: testAliasesValue(setType, "test-name", nil, [2]interface{}{"test-value-1", nil}, [2]interface{}{"test-value-2", nil}, [2]interface{}{"new-value", nil}),
+			},
+			reference: testAliasesValue(setType, nil, "test-name", [2]interface{}{"test-value-1", nil}, [2]interface{}{nil, "test-value-2"}),
+			expected:  testAliasesValue(setType, nil, "test-name", [2]interface{}{"test-value-1", nil}, [2]interface{}{nil, "test-value-2"}, [2]interface{}{"new-value", nil}),
+		},
+		"aliases-not-configured": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(listType, "test-name", nil, [2]interface{}{"test-value", nil}),
+			},
+			reference: testAliasesValue(listType, "test-name", nil, [2]interface{}{"test-value", nil}),
+			expected:  testAliasesValue(listType, "test-name", nil, [2]interface{}{"test-value", nil}),
+		},
+		"aliases-set-by-provider": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(listType, nil, "test-name", [2]interface{}{nil, "test-value"}),
+			},
+			reference: testAliasesValue(listType, nil, "test-name", [2]interface{}{nil, "test-value"}),
+			expected:  testAliasesValue(listType, nil, "test-name", [2]interface{}{nil, "test-value"}),
+		},
+		"reference-null": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(listType, "test-name", nil, [2]interface{}{"test-value", nil}),
+			},
+			reference: tftypes.NewValue(testAliasesValue(listType, nil, nil).Type(), nil),
+			expected:  testAliasesValue(listType, "test-name", nil, [2]interface{}{"test-value", nil}),
+		},
+		"no-aliases": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+					map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "test-name")},
+				),
+			},
+			reference: tftypes.NewValue(
+				tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+				map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, nil)},
+			),
+			expected: tftypes.NewValue(
+				tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+				map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "test-name")},
+			),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.data.RestoreAliases(context.Background(), tc.reference)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.data.TerraformValue, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -8,7 +8,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
-// Get populates the struct passed as `target` with the entire state.
+// Get populates the struct passed as `target` with the entire state. Any
+// configured attribute alias value is only populated into the attribute which
+// declared the alias.
 func (d Data) Get(ctx context.Context, target any) diag.Diagnostics {
-	return reflect.Into(ctx, d.Schema.Type(), d.TerraformValue, target, reflect.Options{}, path.Empty())
+	attrType := d.Schema.Type()
+	tfValue := d.TerraformValue
+
+	if object, _, ok := newAliasesObject(d.Schema); ok && object.hasAliases() {
+		var err error
+
+		attrType = object.withoutAliasesType(attrType, false)
+		tfValue, err = object.withoutAliasesValue(tfValue, attrType.TerraformType(ctx), false)
+
+		if err != nil {
+			return diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					d.Description.Title()+" Read Error",
+					"An unexpected error was encountered trying to read the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Cannot remove attribute aliases: "+err.Error(),
+				),
+			}
+		}
+	}
+
+	return reflect.Into(ctx, attrType, tfValue, target, reflect.Options{}, path.Empty())
 }
@@ -12,7 +12,8 @@ import (
 )
 
 // GetAtPath retrieves the attribute found at `path` and populates the
-// `target` with the value.
+// `target` with the value. Any configured attribute alias value is only
+// populated into the attribute which declared the alias.
 func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) diag.Diagnostics {
 	ctx = logging.FrameworkWithAttributePath(ctx, schemaPath.String())
 
@@ -22,6 +23,18 @@ func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) d
 		return diags
 	}
 
+	if attrValue != nil {
+		var aliasDiags diag.Diagnostics
+
+		attrValue, aliasDiags = d.valueWithoutAliases(ctx, schemaPath, attrValue)
+
+		diags.Append(aliasDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	if attrValue == nil {
 		diags.AddAttributeError(
 			schemaPath,
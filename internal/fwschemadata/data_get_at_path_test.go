@@ -6476,6 +6476,35 @@ func TestDataGetAtPath(t *testing.T) {
 			target:   new(string),
 			expected: pointer("test"),
 		},
+		"aliases-configured": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "test-name", [2]interface{}{nil, "test-value"}),
+			},
+			path:     path.Root("name"),
+			target:   new(types.String),
+			expected: pointer(types.StringValue("test-name")),
+		},
+		"aliases-configured-nested": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "test-name", [2]interface{}{nil, "test-value"}),
+			},
+			path:   path.Root("list"),
+			target: new([]testAliasesElementModel),
+			expected: &[]testAliasesElementModel{
+				{Value: types.StringValue("test-value")},
+			},
+		},
+		"aliases-configured-nested-attribute": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "test-name", [2]interface{}{nil, "test-value"}),
+			},
+			path:     path.Root("list").AtListIndex(0).AtName("value"),
+			target:   new(types.String),
+			expected: pointer(types.StringValue("test-value")),
+		},
 	}
 
 	for name, tc := range testCases {
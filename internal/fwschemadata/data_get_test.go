@@ -7249,6 +7249,32 @@ func TestDataGet(t *testing.T) {
 				String: "test",
 			},
 		},
+		"aliases-canonical-configured": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, "test-name", nil, [2]interface{}{"test-value", nil}),
+			},
+			target: new(testAliasesModel),
+			expected: &testAliasesModel{
+				List: []testAliasesElementModel{
+					{Value: types.StringValue("test-value")},
+				},
+				Name: types.StringValue("test-name"),
+			},
+		},
+		"aliases-configured": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "test-name", [2]interface{}{nil, "test-value"}),
+			},
+			target: new(testAliasesModel),
+			expected: &testAliasesModel{
+				List: []testAliasesElementModel{
+					{Value: types.StringValue("test-value")},
+				},
+				Name: types.StringValue("test-name"),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -11,8 +11,15 @@ import (
 
 // Set replaces the entire value. The value should be a struct whose fields
 // have one of the attr.Value types. Each field must have the tfsdk field tag.
+// Attribute aliases are not included in the struct and are set to null.
 func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
-	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, path.Empty())
+	attrType := d.Schema.Type()
+
+	if object, _, ok := newAliasesObject(d.Schema); ok && object.hasAliases() {
+		attrType = object.withoutAliasesType(attrType, false)
+	}
+
+	attrValue, diags := reflect.FromValue(ctx, attrType, val, path.Empty())
 
 	if diags.HasError() {
 		return diags
@@ -29,6 +36,17 @@ func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
 		return diags
 	}
 
+	tfValue, err = addAliasesValue(tfValue, d.Schema.Type().TerraformType(ctx))
+
+	if err != nil {
+		diags.AddError(
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Error: Unable to add attribute aliases to new value: %s", err),
+		)
+		return diags
+	}
+
 	d.TerraformValue = tfValue
 
 	return diags
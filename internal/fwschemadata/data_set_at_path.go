@@ -22,6 +22,7 @@ import (
 // paths as necessary.
 //
 // Lists can only have the next element added according to the current length.
+// Attribute aliases are not included in the value and are set to null.
 func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -47,7 +48,14 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		return diags
 	}
 
-	newVal, newValDiags := reflect.FromValue(ctx, attrType, val, path)
+	valType := attrType
+	object, collection, hasAliases := d.aliasesObjectAtPath(ctx, path)
+
+	if hasAliases {
+		valType = object.withoutAliasesType(attrType, collection)
+	}
+
+	newVal, newValDiags := reflect.FromValue(ctx, valType, val, path)
 	diags.Append(newValDiags...)
 
 	if diags.HasError() {
@@ -66,6 +74,20 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		return diags
 	}
 
+	if hasAliases {
+		tfVal, err = addAliasesValue(tfVal, attrType.TerraformType(ctx))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path,
+				d.Description.Title()+" Write Error",
+				"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Error: Cannot add attribute aliases to new data value: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
 	if attrTypeWithValidate, ok := attrType.(xattr.TypeWithValidate); ok {
 		logging.FrameworkTrace(ctx, "Type implements TypeWithValidate")
 		logging.FrameworkDebug(ctx, "Calling provider defined Type Validate")
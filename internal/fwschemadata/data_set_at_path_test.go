@@ -2539,6 +2539,26 @@ func TestDataSetAtPath(t *testing.T) {
 				testtypes.TestWarningDiagnostic(path.Root("name")),
 			},
 		},
+		"aliases": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "old-name", [2]interface{}{nil, "old-value"}),
+			},
+			path:     path.Root("name"),
+			val:      "test-name",
+			expected: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, "test-name", "old-name", [2]interface{}{nil, "old-value"}),
+		},
+		"aliases-nested": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "old-name", [2]interface{}{nil, "old-value"}),
+			},
+			path: path.Root("list"),
+			val: []testAliasesElementModel{
+				{Value: types.StringValue("test-value")},
+			},
+			expected: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "old-name", [2]interface{}{"test-value", nil}),
+		},
 	}
 
 	for name, tc := range testCases {
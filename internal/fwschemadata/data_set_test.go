@@ -169,6 +169,19 @@ func TestDataSet(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
+		"aliases": {
+			data: fwschemadata.Data{
+				Schema:         testAliasesSchema(fwschema.NestingModeList),
+				TerraformValue: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, nil, "old-name", [2]interface{}{nil, "old-value"}),
+			},
+			val: testAliasesModel{
+				List: []testAliasesElementModel{
+					{Value: types.StringValue("test-value")},
+				},
+				Name: types.StringValue("test-name"),
+			},
+			expected: testAliasesValue(tftypes.List{ElementType: testAliasesElementType}, "test-name", nil, [2]interface{}{"test-value", nil}),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -70,7 +70,7 @@ func listElemObject(ctx context.Context, schemaPath path.Path, list types.List,
 		return listElemObjectFromTerraformValue(ctx, schemaPath, list, description, tftypes.UnknownValue)
 	}
 
-	if index >= len(list.Elements()) {
+	if index >= list.Len() {
 		return listElemObjectFromTerraformValue(ctx, schemaPath, list, description, nil)
 	}
 
@@ -160,7 +160,7 @@ func setElemObject(ctx context.Context, schemaPath path.Path, set types.Set, ind
 		return setElemObjectFromTerraformValue(ctx, schemaPath, set, description, tftypes.UnknownValue)
 	}
 
-	if index >= len(set.Elements()) {
+	if index >= set.Len() {
 		return setElemObjectFromTerraformValue(ctx, schemaPath, set, description, nil)
 	}
 
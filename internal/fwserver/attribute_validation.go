@@ -3,6 +3,7 @@ package fwserver
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,6 +32,55 @@ type ValidateAttributeRequest struct {
 
 	// Config contains the entire configuration of the data source, provider, or resource.
 	Config tfsdk.Config
+
+	// SuppressedWarningPaths contains the expressions of attributes and blocks
+	// which should not raise framework-generated warnings, such as deprecation
+	// warnings. Error diagnostics are never suppressed.
+	SuppressedWarningPaths path.Expressions
+
+	// ParentBlockDeprecationMessage is the deprecation message of the nearest
+	// deprecated block the attribute or block is underneath, if any. The block
+	// deprecation warning supersedes any attribute or block deprecation
+	// warning underneath it with the same message.
+	ParentBlockDeprecationMessage string
+
+	// DiagnosticSink, if set, is sent each diagnostic of the attribute or
+	// block validation, including nested validation, as it is produced.
+	DiagnosticSink DiagnosticSink
+
+	// OnApply is true when validating the configuration during resource
+	// apply, in which case only validators implementing validator.OnApply
+	// are called.
+	OnApply bool
+
+	// validatorCache records the validator.Memoizable validators which
+	// returned no diagnostics during the validation request. Validators are
+	// not memoized when nil.
+	validatorCache *validatorCache
+}
+
+// validatorSkipped returns true if the validator should not be called for
+// the request.
+func (r ValidateAttributeRequest) validatorSkipped(v validator.Describer) bool {
+	if !r.OnApply {
+		return false
+	}
+
+	onApplyValidator, ok := v.(validator.OnApply)
+
+	return !ok || !onApplyValidator.ValidateOnApply()
+}
+
+// warningSuppressed returns true if framework-generated warnings for the
+// given path should not be raised.
+func (r ValidateAttributeRequest) warningSuppressed(p path.Path) bool {
+	for _, expression := range r.SuppressedWarningPaths {
+		if expression.Matches(p) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ValidateAttributeResponse represents a response to a
@@ -52,6 +102,12 @@ type ValidateAttributeResponse struct {
 func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	ctx = logging.FrameworkWithAttributePath(ctx, req.AttributePath.String())
 
+	req.DiagnosticSink = newUniqueDiagnosticSink(req.DiagnosticSink)
+
+	sender := req.newDiagnosticSender(resp)
+
+	defer sender.send(ctx)
+
 	if !a.IsRequired() && !a.IsOptional() && !a.IsComputed() {
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
@@ -105,6 +161,30 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 
 	req.AttributeConfig = attributeConfig
 
+	AttributeValidateValidators(ctx, a, req, resp)
+
+	sender.send(ctx)
+
+	AttributeValidateNestedAttributes(ctx, a, req, resp)
+
+	sender.markSent()
+
+	// Show deprecation warnings only for known values.
+	if a.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() && a.GetDeprecationMessage() != req.ParentBlockDeprecationMessage && !req.warningSuppressed(req.AttributePath) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.AttributePath,
+			"Attribute Deprecated",
+			a.GetDeprecationMessage(),
+		)
+	}
+
+	AttributeValidateAliases(ctx, a, configData, req, resp)
+}
+
+// AttributeValidateValidators calls the validators of the Attribute type,
+// such as String validators for a StringAttribute, with the
+// AttributeConfig of the request.
+func AttributeValidateValidators(ctx context.Context, a fwschema.Attribute, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	switch attributeWithValidators := a.(type) {
 	case fwxschema.AttributeWithBoolValidators:
 		AttributeValidateBool(ctx, attributeWithValidators, req, resp)
@@ -125,15 +205,61 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	case fwxschema.AttributeWithStringValidators:
 		AttributeValidateString(ctx, attributeWithValidators, req, resp)
 	}
+}
+
+// AttributeValidateAliases performs validation of any configured alias of the
+// attribute. A warning diagnostic is raised for a configured alias, while an
+// error diagnostic is raised if the attribute and an alias, or multiple
+// aliases, are configured.
+func AttributeValidateAliases(ctx context.Context, a fwschema.Attribute, configData *fwschemadata.Data, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	aliases := fwschema.AttributeAliases(a)
+
+	if len(aliases) == 0 {
+		return
+	}
 
-	AttributeValidateNestedAttributes(ctx, a, req, resp)
+	var configuredPath *path.Path
+
+	if !req.AttributeConfig.IsNull() {
+		configuredPath = &req.AttributePath
+	}
+
+	for _, alias := range aliases {
+		aliasPath := req.AttributePath.ParentPath().AtName(alias)
+
+		aliasConfig, diags := configData.ValueAtPath(ctx, aliasPath)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if aliasConfig.IsNull() {
+			continue
+		}
+
+		if configuredPath != nil {
+			resp.Diagnostics.AddAttributeError(
+				aliasPath,
+				"Conflicting Attribute Alias Configuration",
+				fmt.Sprintf("The %s and %s attribute names refer to the same attribute and cannot both be configured. ", configuredPath, aliasPath)+
+					fmt.Sprintf("Configure only the %s attribute.", req.AttributePath),
+			)
+
+			continue
+		}
+
+		configuredPath = &aliasPath
+
+		if req.warningSuppressed(aliasPath) {
+			continue
+		}
 
-	// Show deprecation warnings only for known values.
-	if a.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
 		resp.Diagnostics.AddAttributeWarning(
-			req.AttributePath,
-			"Attribute Deprecated",
-			a.GetDeprecationMessage(),
+			aliasPath,
+			"Attribute Alias Deprecated",
+			fmt.Sprintf("The %s attribute name is a deprecated alias. Configure the %s attribute instead.", aliasPath, req.AttributePath),
 		)
 	}
 }
@@ -176,7 +302,25 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.BoolValidators() {
+	for idx, attributeValidator := range attribute.BoolValidators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.BoolResponse{}
@@ -199,6 +343,10 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -241,7 +389,25 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.Float64Validators() {
+	for idx, attributeValidator := range attribute.Float64Validators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.Float64Response{}
@@ -264,6 +430,10 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -306,7 +476,25 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.Int64Validators() {
+	for idx, attributeValidator := range attribute.Int64Validators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.Int64Response{}
@@ -329,6 +517,10 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -371,7 +563,25 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.ListValidators() {
+	for idx, attributeValidator := range attribute.ListValidators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ListResponse{}
@@ -394,6 +604,10 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -436,7 +650,25 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.MapValidators() {
+	for idx, attributeValidator := range attribute.MapValidators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.MapResponse{}
@@ -459,6 +691,10 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -501,7 +737,25 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.NumberValidators() {
+	for idx, attributeValidator := range attribute.NumberValidators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.NumberResponse{}
@@ -524,6 +778,10 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -566,7 +824,25 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.ObjectValidators() {
+	for idx, attributeValidator := range attribute.ObjectValidators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ObjectResponse{}
@@ -589,6 +865,10 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -631,7 +911,25 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.SetValidators() {
+	for idx, attributeValidator := range attribute.SetValidators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.SetResponse{}
@@ -654,6 +952,10 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -696,7 +998,25 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, attributeValidator := range attribute.StringValidators() {
+	for idx, attributeValidator := range attribute.StringValidators() {
+		if req.validatorSkipped(attributeValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, attributeValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.StringResponse{}
@@ -719,6 +1039,10 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -736,6 +1060,12 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 		return
 	}
 
+	req.DiagnosticSink = newUniqueDiagnosticSink(req.DiagnosticSink)
+
+	sender := req.newDiagnosticSender(resp)
+
+	defer sender.send(ctx)
+
 	nestedAttributeObject := nestedAttribute.GetNestedObject()
 
 	nm := nestedAttribute.GetNestingMode()
@@ -763,16 +1093,22 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 
 		for idx, value := range l.Elements() {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtListIndex(idx),
-				AttributePathExpression: req.AttributePathExpression.AtListIndex(idx),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				AttributePath:                 req.AttributePath.AtListIndex(idx),
+				AttributePathExpression:       req.AttributePathExpression.AtListIndex(idx),
+				Config:                        req.Config,
+				SuppressedWarningPaths:        req.SuppressedWarningPaths,
+				ParentBlockDeprecationMessage: req.ParentBlockDeprecationMessage,
+				DiagnosticSink:                req.DiagnosticSink,
+				validatorCache:                req.validatorCache,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
+			sender.send(ctx)
+
 			NestedAttributeObjectValidate(ctx, nestedAttributeObject, nestedAttributeObjectReq, nestedAttributeObjectResp)
 
-			resp.Diagnostics.Append(nestedAttributeObjectResp.Diagnostics...)
+			sender.appendSent(nestedAttributeObjectResp.Diagnostics...)
 		}
 	case fwschema.NestingModeSet:
 		setVal, ok := req.AttributeConfig.(basetypes.SetValuable)
@@ -797,16 +1133,22 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 
 		for _, value := range s.Elements() {
 			nestedAttributeObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtSetValue(value),
-				AttributePathExpression: req.AttributePathExpression.AtSetValue(value),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				AttributePath:                 req.AttributePath.AtSetValue(value),
+				AttributePathExpression:       req.AttributePathExpression.AtSetValue(value),
+				Config:                        req.Config,
+				SuppressedWarningPaths:        req.SuppressedWarningPaths,
+				ParentBlockDeprecationMessage: req.ParentBlockDeprecationMessage,
+				DiagnosticSink:                req.DiagnosticSink,
+				validatorCache:                req.validatorCache,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
+			sender.send(ctx)
+
 			NestedAttributeObjectValidate(ctx, nestedAttributeObject, nestedAttributeObjectReq, nestedAttributeObjectResp)
 
-			resp.Diagnostics.Append(nestedAttributeObjectResp.Diagnostics...)
+			sender.appendSent(nestedAttributeObjectResp.Diagnostics...)
 		}
 	case fwschema.NestingModeMap:
 		mapVal, ok := req.AttributeConfig.(basetypes.MapValuable)
@@ -829,18 +1171,35 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
-		for key, value := range m.Elements() {
+		elements := m.Elements()
+		keys := make([]string, 0, len(elements))
+
+		for key := range elements {
+			keys = append(keys, key)
+		}
+
+		// Sort keys for consistent diagnostics ordering.
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value := elements[key]
 			nestedAttributeObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtMapKey(key),
-				AttributePathExpression: req.AttributePathExpression.AtMapKey(key),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				AttributePath:                 req.AttributePath.AtMapKey(key),
+				AttributePathExpression:       req.AttributePathExpression.AtMapKey(key),
+				Config:                        req.Config,
+				SuppressedWarningPaths:        req.SuppressedWarningPaths,
+				ParentBlockDeprecationMessage: req.ParentBlockDeprecationMessage,
+				DiagnosticSink:                req.DiagnosticSink,
+				validatorCache:                req.validatorCache,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
+			sender.send(ctx)
+
 			NestedAttributeObjectValidate(ctx, nestedAttributeObject, nestedAttributeObjectReq, nestedAttributeObjectResp)
 
-			resp.Diagnostics.Append(nestedAttributeObjectResp.Diagnostics...)
+			sender.appendSent(nestedAttributeObjectResp.Diagnostics...)
 		}
 	case fwschema.NestingModeSingle:
 		objectVal, ok := req.AttributeConfig.(basetypes.ObjectValuable)
@@ -868,16 +1227,22 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 		}
 
 		nestedAttributeObjectReq := ValidateAttributeRequest{
-			AttributeConfig:         o,
-			AttributePath:           req.AttributePath,
-			AttributePathExpression: req.AttributePathExpression,
-			Config:                  req.Config,
+			AttributeConfig:               o,
+			AttributePath:                 req.AttributePath,
+			AttributePathExpression:       req.AttributePathExpression,
+			Config:                        req.Config,
+			SuppressedWarningPaths:        req.SuppressedWarningPaths,
+			ParentBlockDeprecationMessage: req.ParentBlockDeprecationMessage,
+			DiagnosticSink:                req.DiagnosticSink,
+			validatorCache:                req.validatorCache,
 		}
 		nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
+		sender.send(ctx)
+
 		NestedAttributeObjectValidate(ctx, nestedAttributeObject, nestedAttributeObjectReq, nestedAttributeObjectResp)
 
-		resp.Diagnostics.Append(nestedAttributeObjectResp.Diagnostics...)
+		sender.appendSent(nestedAttributeObjectResp.Diagnostics...)
 	default:
 		err := fmt.Errorf("unknown attribute validation nesting mode (%T: %v) at path: %s", nm, nm, req.AttributePath)
 		resp.Diagnostics.AddAttributeError(
@@ -891,6 +1256,12 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 }
 
 func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttributeObject, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	req.DiagnosticSink = newUniqueDiagnosticSink(req.DiagnosticSink)
+
+	sender := req.newDiagnosticSender(resp)
+
+	defer sender.send(ctx)
+
 	objectWithValidators, ok := o.(fwxschema.NestedAttributeObjectWithValidators)
 
 	if ok {
@@ -926,6 +1297,14 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 		}
 
 		for _, objectValidator := range objectWithValidators.ObjectValidators() {
+			// Stop calling validators, which may perform network calls, once
+			// the context is cancelled or its deadline is exceeded.
+			if ctx.Err() != nil {
+				resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+				return
+			}
+
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			validateResp := &validator.ObjectResponse{}
@@ -952,16 +1331,52 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	attributes := o.GetAttributes()
+
+	for _, nestedName := range sortedAttributeNames(attributes) {
+		nestedAttr := attributes[nestedName]
 		nestedAttrReq := ValidateAttributeRequest{
-			AttributePath:           req.AttributePath.AtName(nestedName),
-			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
-			Config:                  req.Config,
+			AttributePath:                 req.AttributePath.AtName(nestedName),
+			AttributePathExpression:       req.AttributePathExpression.AtName(nestedName),
+			Config:                        req.Config,
+			SuppressedWarningPaths:        req.SuppressedWarningPaths,
+			ParentBlockDeprecationMessage: req.ParentBlockDeprecationMessage,
+			DiagnosticSink:                req.DiagnosticSink,
+			validatorCache:                req.validatorCache,
 		}
 		nestedAttrResp := &ValidateAttributeResponse{}
 
+		sender.send(ctx)
+
 		AttributeValidate(ctx, nestedAttr, nestedAttrReq, nestedAttrResp)
 
-		resp.Diagnostics.Append(nestedAttrResp.Diagnostics...)
+		sender.appendSent(nestedAttrResp.Diagnostics...)
 	}
 }
+
+// sortedAttributeNames returns the names of the given attributes in sorted
+// order, so validation diagnostics are consistently ordered.
+func sortedAttributeNames(attributes map[string]fwschema.Attribute) []string {
+	names := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// validatorCancelledDiagnostic returns the diagnostic for when validation
+// stops calling further validators due to the context being cancelled or its
+// deadline being exceeded. The diagnostic is intentionally not associated
+// with an attribute path, so it is only reported once per response.
+func validatorCancelledDiagnostic(ctx context.Context) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Validation Cancelled",
+		"Validation was stopped before all validators could be called, as the request was cancelled or exceeded its deadline. "+
+			"Remaining validators were not called and the configuration may not be fully validated.\n\n"+
+			fmt.Sprintf("Error: %s", ctx.Err()),
+	)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -383,6 +385,104 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"deprecation-message-known-suppressed": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.StringType,
+								Optional:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+				SuppressedWarningPaths: path.Expressions{
+					path.MatchRoot("test"),
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"deprecation-message-known-suppressed-other-path": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.StringType,
+								Optional:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+				SuppressedWarningPaths: path.Expressions{
+					path.MatchRoot("other"),
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
+		"deprecation-message-known-suppressed-errors-preserved": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.StringType,
+								Computed:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+				SuppressedWarningPaths: path.Expressions{
+					path.MatchRoot("test"),
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Configuration for Read-Only Attribute",
+						"Cannot set value for this attribute as the provider has marked it as read-only. Remove the configuration line setting the value.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable and read-only attributes that are supported.",
+					),
+				},
+			},
+		},
 		"deprecation-message-null": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -1643,6 +1743,178 @@ func TestAttributeValidate(t *testing.T) {
 	}
 }
 
+func TestAttributeValidateAliases(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Aliases:  []string{"old_test", "older_test"},
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		req  ValidateAttributeRequest
+		resp ValidateAttributeResponse
+	}{
+		"unconfigured": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test":       tftypes.String,
+							"old_test":   tftypes.String,
+							"older_test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test":       tftypes.NewValue(tftypes.String, nil),
+						"old_test":   tftypes.NewValue(tftypes.String, nil),
+						"older_test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"canonical-only": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test":       tftypes.String,
+							"old_test":   tftypes.String,
+							"older_test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test":       tftypes.NewValue(tftypes.String, "value"),
+						"old_test":   tftypes.NewValue(tftypes.String, nil),
+						"older_test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"alias-only": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test":       tftypes.String,
+							"old_test":   tftypes.String,
+							"older_test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test":       tftypes.NewValue(tftypes.String, nil),
+						"old_test":   tftypes.NewValue(tftypes.String, "value"),
+						"older_test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("old_test"),
+						"Attribute Alias Deprecated",
+						"The old_test attribute name is a deprecated alias. Configure the test attribute instead.",
+					),
+				},
+			},
+		},
+		"canonical-and-alias": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test":       tftypes.String,
+							"old_test":   tftypes.String,
+							"older_test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test":       tftypes.NewValue(tftypes.String, "value"),
+						"old_test":   tftypes.NewValue(tftypes.String, "value"),
+						"older_test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("old_test"),
+						"Conflicting Attribute Alias Configuration",
+						"The test and old_test attribute names refer to the same attribute and cannot both be configured. Configure only the test attribute.",
+					),
+				},
+			},
+		},
+		"multiple-aliases": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test":       tftypes.String,
+							"old_test":   tftypes.String,
+							"older_test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test":       tftypes.NewValue(tftypes.String, nil),
+						"old_test":   tftypes.NewValue(tftypes.String, "value"),
+						"older_test": tftypes.NewValue(tftypes.String, "value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("old_test"),
+						"Attribute Alias Deprecated",
+						"The old_test attribute name is a deprecated alias. Configure the test attribute instead.",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("older_test"),
+						"Conflicting Attribute Alias Configuration",
+						"The old_test and older_test attribute names refer to the same attribute and cannot both be configured. Configure only the test attribute.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var got ValidateAttributeResponse
+
+			attribute, diags := tc.req.Config.Schema.AttributeAtPath(ctx, tc.req.AttributePath)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %s", diags)
+			}
+
+			AttributeValidate(ctx, attribute, tc.req, &got)
+
+			if diff := cmp.Diff(got, tc.resp); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAttributeValidateBool(t *testing.T) {
 	t.Parallel()
 
@@ -2290,6 +2562,35 @@ func TestAttributeValidateList(t *testing.T) {
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
+		"response-diagnostics-element-paths": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.HasPrefix("a")),
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringValue("bad"),
+					},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(1),
+						"Invalid Attribute Value",
+						`Attribute test[1] value must start with "a", got: "bad"`,
+					),
+				},
+			},
+		},
 		"request-pathexpression": {
 			attribute: testschema.AttributeWithListValidators{
 				ElementType: types.StringType,
@@ -3585,6 +3886,315 @@ func TestAttributeValidateString(t *testing.T) {
 	}
 }
 
+func TestAttributeValidateStringContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	defer cancel()
+
+	attribute := testschema.AttributeWithStringValidators{
+		Validators: []validator.String{
+			testvalidator.String{
+				ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+					resp.Diagnostics.AddAttributeWarning(req.Path, "First Validator Summary", "First Validator Details")
+
+					// Simulate the request being cancelled during a validator.
+					cancel()
+				},
+			},
+			testvalidator.String{
+				ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+					resp.Diagnostics.AddAttributeError(req.Path, "Unexpected Validator Call", "The second validator should not be called.")
+				},
+			},
+		},
+	}
+	request := ValidateAttributeRequest{
+		AttributePath:   path.Root("test"),
+		AttributeConfig: types.StringValue("test"),
+	}
+	response := &ValidateAttributeResponse{}
+	expected := &ValidateAttributeResponse{
+		Diagnostics: diag.Diagnostics{
+			diag.NewAttributeWarningDiagnostic(
+				path.Root("test"),
+				"First Validator Summary",
+				"First Validator Details",
+			),
+			diag.NewErrorDiagnostic(
+				"Validation Cancelled",
+				"Validation was stopped before all validators could be called, as the request was cancelled or exceeded its deadline. "+
+					"Remaining validators were not called and the configuration may not be fully validated.\n\n"+
+					"Error: context canceled",
+			),
+		},
+	}
+
+	AttributeValidateString(ctx, attribute, request, response)
+
+	if diff := cmp.Diff(response, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestAttributeValidateContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancel()
+
+	var validatorCalls int
+
+	stringValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			validatorCalls++
+		},
+	}
+
+	req := ValidateAttributeRequest{
+		AttributePath: path.Root("test"),
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"first":  tftypes.String,
+								"second": tftypes.String,
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"first":  tftypes.String,
+								"second": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"first":  tftypes.NewValue(tftypes.String, "one"),
+							"second": tftypes.NewValue(tftypes.String, "two"),
+						},
+					),
+				},
+			),
+			Schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"first": testschema.AttributeWithStringValidators{
+									Required:   true,
+									Validators: []validator.String{stringValidator},
+								},
+								"second": testschema.AttributeWithStringValidators{
+									Required:   true,
+									Validators: []validator.String{stringValidator},
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeSingle,
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+
+	attribute, diags := req.Config.Schema.AttributeAtPath(ctx, req.AttributePath)
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %s", diags)
+	}
+
+	var got ValidateAttributeResponse
+
+	AttributeValidate(ctx, attribute, req, &got)
+
+	expected := ValidateAttributeResponse{
+		Diagnostics: diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Validation Cancelled",
+				"Validation was stopped before all validators could be called, as the request was cancelled or exceeded its deadline. "+
+					"Remaining validators were not called and the configuration may not be fully validated.\n\n"+
+					"Error: context canceled",
+			),
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+	}
+
+	if validatorCalls != 0 {
+		t.Errorf("Expected no validator calls, got: %d", validatorCalls)
+	}
+}
+
+func TestAttributeValidateNestedAttributesOrdering(t *testing.T) {
+	t.Parallel()
+
+	nestedNames := []string{"a", "b", "c", "d", "e"}
+	nestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{},
+	}
+	nestedObjectValues := map[string]tftypes.Value{}
+	nestedAttributes := map[string]fwschema.Attribute{}
+
+	for _, name := range nestedNames {
+		nestedObjectType.AttributeTypes[name] = tftypes.String
+		nestedObjectValues[name] = tftypes.NewValue(tftypes.String, "read-only")
+		nestedAttributes[name] = testschema.Attribute{
+			Computed: true,
+			Type:     types.StringType,
+		}
+	}
+
+	nestedObjectValue := tftypes.NewValue(nestedObjectType, nestedObjectValues)
+
+	testCases := map[string]struct {
+		nestingMode   fwschema.NestingMode
+		attributeType tftypes.Type
+		attributeVal  tftypes.Value
+		objectPaths   path.Paths
+	}{
+		"list": {
+			nestingMode:   fwschema.NestingModeList,
+			attributeType: tftypes.List{ElementType: nestedObjectType},
+			attributeVal: tftypes.NewValue(
+				tftypes.List{ElementType: nestedObjectType},
+				[]tftypes.Value{nestedObjectValue},
+			),
+			objectPaths: path.Paths{
+				path.Root("test").AtListIndex(0),
+			},
+		},
+		"map": {
+			nestingMode:   fwschema.NestingModeMap,
+			attributeType: tftypes.Map{ElementType: nestedObjectType},
+			attributeVal: tftypes.NewValue(
+				tftypes.Map{ElementType: nestedObjectType},
+				map[string]tftypes.Value{
+					"key3": nestedObjectValue,
+					"key1": nestedObjectValue,
+					"key2": nestedObjectValue,
+				},
+			),
+			objectPaths: path.Paths{
+				path.Root("test").AtMapKey("key1"),
+				path.Root("test").AtMapKey("key2"),
+				path.Root("test").AtMapKey("key3"),
+			},
+		},
+		"set": {
+			nestingMode:   fwschema.NestingModeSet,
+			attributeType: tftypes.Set{ElementType: nestedObjectType},
+			attributeVal: tftypes.NewValue(
+				tftypes.Set{ElementType: nestedObjectType},
+				[]tftypes.Value{nestedObjectValue},
+			),
+			objectPaths: path.Paths{
+				path.Root("test").AtSetValue(
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"a": types.StringType,
+							"b": types.StringType,
+							"c": types.StringType,
+							"d": types.StringType,
+							"e": types.StringType,
+						},
+						map[string]attr.Value{
+							"a": types.StringValue("read-only"),
+							"b": types.StringValue("read-only"),
+							"c": types.StringValue("read-only"),
+							"d": types.StringValue("read-only"),
+							"e": types.StringValue("read-only"),
+						},
+					),
+				),
+			},
+		},
+		"single": {
+			nestingMode:   fwschema.NestingModeSingle,
+			attributeType: nestedObjectType,
+			attributeVal:  nestedObjectValue,
+			objectPaths: path.Paths{
+				path.Root("test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": testCase.attributeType,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": testCase.attributeVal,
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: nestedAttributes,
+								},
+								NestingMode: testCase.nestingMode,
+								Optional:    true,
+							},
+						},
+					},
+				},
+			}
+
+			var expected diag.Diagnostics
+
+			for _, objectPath := range testCase.objectPaths {
+				for _, nestedName := range nestedNames {
+					expected.AddAttributeError(
+						objectPath.AtName(nestedName),
+						"Invalid Configuration for Read-Only Attribute",
+						"Cannot set value for this attribute as the provider has marked it as read-only. Remove the configuration line setting the value.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable and read-only attributes that are supported.",
+					)
+				}
+			}
+
+			attribute, diags := req.Config.Schema.AttributeAtPath(context.Background(), req.AttributePath)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %s", diags)
+			}
+
+			// Map iteration is randomized, so repeated validation would
+			// eventually return a different ordering if not sorted.
+			for i := 0; i < 20; i++ {
+				var got ValidateAttributeResponse
+
+				AttributeValidate(context.Background(), attribute, req, &got)
+
+				if diff := cmp.Diff(got.Diagnostics, expected); diff != "" {
+					t.Fatalf("Unexpected diagnostics on run %d (+wanted, -got): %s", i, diff)
+				}
+			}
+		})
+	}
+}
+
 func TestNestedAttributeObjectValidateObject(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package fwserver
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
@@ -19,6 +20,12 @@ import (
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	req.DiagnosticSink = newUniqueDiagnosticSink(req.DiagnosticSink)
+
+	sender := req.newDiagnosticSender(resp)
+
+	defer sender.send(ctx)
+
 	configData := &fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionConfiguration,
 		Schema:         req.Config.Schema,
@@ -34,17 +41,23 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 
 	req.AttributeConfig = attributeConfig
 
-	switch blockWithValidators := b.(type) {
-	case fwxschema.BlockWithListValidators:
-		BlockValidateList(ctx, blockWithValidators, req, resp)
-	case fwxschema.BlockWithObjectValidators:
-		BlockValidateObject(ctx, blockWithValidators, req, resp)
-	case fwxschema.BlockWithSetValidators:
-		BlockValidateSet(ctx, blockWithValidators, req, resp)
-	}
+	BlockValidateValidators(ctx, b, req, resp)
 
 	nestedBlockObject := b.GetNestedObject()
 
+	// If the block is deprecated, its single deprecation warning supersedes
+	// any attribute or block deprecation warnings underneath it with the same
+	// message.
+	nestedDeprecationMessage := req.ParentBlockDeprecationMessage
+
+	if b.GetDeprecationMessage() != "" {
+		nestedDeprecationMessage = b.GetDeprecationMessage()
+	}
+
+	// configured is true if any nested block object is configured, which
+	// enables a single deprecation warning for the whole block.
+	var configured bool
+
 	nm := b.GetNestingMode()
 	switch nm {
 	case fwschema.BlockNestingModeList:
@@ -68,18 +81,26 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			return
 		}
 
+		configured = l.Len() > 0
+
 		for idx, value := range l.Elements() {
 			nestedBlockObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtListIndex(idx),
-				AttributePathExpression: req.AttributePathExpression.AtListIndex(idx),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				AttributePath:                 req.AttributePath.AtListIndex(idx),
+				AttributePathExpression:       req.AttributePathExpression.AtListIndex(idx),
+				Config:                        req.Config,
+				SuppressedWarningPaths:        req.SuppressedWarningPaths,
+				ParentBlockDeprecationMessage: nestedDeprecationMessage,
+				DiagnosticSink:                req.DiagnosticSink,
+				validatorCache:                req.validatorCache,
 			}
 			nestedBlockObjectResp := &ValidateAttributeResponse{}
 
+			sender.send(ctx)
+
 			NestedBlockObjectValidate(ctx, nestedBlockObject, nestedBlockObjectReq, nestedBlockObjectResp)
 
-			resp.Diagnostics.Append(nestedBlockObjectResp.Diagnostics...)
+			sender.appendSent(nestedBlockObjectResp.Diagnostics...)
 		}
 	case fwschema.BlockNestingModeSet:
 		setVal, ok := req.AttributeConfig.(basetypes.SetValuable)
@@ -102,18 +123,26 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			return
 		}
 
+		configured = s.Len() > 0
+
 		for _, value := range s.Elements() {
 			nestedBlockObjectReq := ValidateAttributeRequest{
-				AttributeConfig:         value,
-				AttributePath:           req.AttributePath.AtSetValue(value),
-				AttributePathExpression: req.AttributePathExpression.AtSetValue(value),
-				Config:                  req.Config,
+				AttributeConfig:               value,
+				AttributePath:                 req.AttributePath.AtSetValue(value),
+				AttributePathExpression:       req.AttributePathExpression.AtSetValue(value),
+				Config:                        req.Config,
+				SuppressedWarningPaths:        req.SuppressedWarningPaths,
+				ParentBlockDeprecationMessage: nestedDeprecationMessage,
+				DiagnosticSink:                req.DiagnosticSink,
+				validatorCache:                req.validatorCache,
 			}
 			nestedBlockObjectResp := &ValidateAttributeResponse{}
 
+			sender.send(ctx)
+
 			NestedBlockObjectValidate(ctx, nestedBlockObject, nestedBlockObjectReq, nestedBlockObjectResp)
 
-			resp.Diagnostics.Append(nestedBlockObjectResp.Diagnostics...)
+			sender.appendSent(nestedBlockObjectResp.Diagnostics...)
 		}
 	case fwschema.BlockNestingModeSingle:
 		objectVal, ok := req.AttributeConfig.(basetypes.ObjectValuable)
@@ -136,17 +165,25 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			return
 		}
 
+		configured = !o.IsNull() && !o.IsUnknown()
+
 		nestedBlockObjectReq := ValidateAttributeRequest{
-			AttributeConfig:         o,
-			AttributePath:           req.AttributePath,
-			AttributePathExpression: req.AttributePathExpression,
-			Config:                  req.Config,
+			AttributeConfig:               o,
+			AttributePath:                 req.AttributePath,
+			AttributePathExpression:       req.AttributePathExpression,
+			Config:                        req.Config,
+			SuppressedWarningPaths:        req.SuppressedWarningPaths,
+			ParentBlockDeprecationMessage: nestedDeprecationMessage,
+			DiagnosticSink:                req.DiagnosticSink,
+			validatorCache:                req.validatorCache,
 		}
 		nestedBlockObjectResp := &ValidateAttributeResponse{}
 
+		sender.send(ctx)
+
 		NestedBlockObjectValidate(ctx, nestedBlockObject, nestedBlockObjectReq, nestedBlockObjectResp)
 
-		resp.Diagnostics.Append(nestedBlockObjectResp.Diagnostics...)
+		sender.appendSent(nestedBlockObjectResp.Diagnostics...)
 	default:
 		err := fmt.Errorf("unknown block validation nesting mode (%T: %v) at path: %s", nm, nm, req.AttributePath)
 		resp.Diagnostics.AddAttributeError(
@@ -158,8 +195,9 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 		return
 	}
 
-	// Show deprecation warning only on known values.
-	if b.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
+	// Show a single deprecation warning for the whole block only when any
+	// nested block object is configured with a known value.
+	if b.GetDeprecationMessage() != "" && configured && b.GetDeprecationMessage() != req.ParentBlockDeprecationMessage && !req.warningSuppressed(req.AttributePath) {
 		resp.Diagnostics.AddAttributeWarning(
 			req.AttributePath,
 			"Block Deprecated",
@@ -168,6 +206,20 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 	}
 }
 
+// BlockValidateValidators calls the validators of the Block type, such as
+// List validators for a ListNestedBlock, with the AttributeConfig of the
+// request.
+func BlockValidateValidators(ctx context.Context, b fwschema.Block, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	switch blockWithValidators := b.(type) {
+	case fwxschema.BlockWithListValidators:
+		BlockValidateList(ctx, blockWithValidators, req, resp)
+	case fwxschema.BlockWithObjectValidators:
+		BlockValidateObject(ctx, blockWithValidators, req, resp)
+	case fwxschema.BlockWithSetValidators:
+		BlockValidateSet(ctx, blockWithValidators, req, resp)
+	}
+}
+
 // BlockValidateList performs all types.List validation.
 func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.ListValuable until custom types cannot re-implement
@@ -206,7 +258,25 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, blockValidator := range block.ListValidators() {
+	for idx, blockValidator := range block.ListValidators() {
+		if req.validatorSkipped(blockValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, blockValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ListResponse{}
@@ -229,6 +299,10 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -271,7 +345,25 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, blockValidator := range block.ObjectValidators() {
+	for idx, blockValidator := range block.ObjectValidators() {
+		if req.validatorSkipped(blockValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, blockValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.ObjectResponse{}
@@ -294,6 +386,10 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
@@ -336,7 +432,25 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 		PathExpression: req.AttributePathExpression,
 	}
 
-	for _, blockValidator := range block.SetValidators() {
+	for idx, blockValidator := range block.SetValidators() {
+		if req.validatorSkipped(blockValidator) {
+			continue
+		}
+
+		cacheKey, cacheable := req.validatorCacheKey(ctx, blockValidator, idx, validateReq.ConfigValue)
+
+		if cacheable && req.validatorSucceeded(cacheKey) {
+			continue
+		}
+
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
 		validateResp := &validator.SetResponse{}
@@ -359,11 +473,21 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 			},
 		)
 
+		if cacheable && len(validateResp.Diagnostics) == 0 {
+			req.recordValidatorSucceeded(cacheKey)
+		}
+
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
 }
 
 func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	req.DiagnosticSink = newUniqueDiagnosticSink(req.DiagnosticSink)
+
+	sender := req.newDiagnosticSender(resp)
+
+	defer sender.send(ctx)
+
 	objectWithValidators, ok := o.(fwxschema.NestedBlockObjectWithValidators)
 
 	if ok {
@@ -399,6 +523,14 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		}
 
 		for _, objectValidator := range objectWithValidators.ObjectValidators() {
+			// Stop calling validators, which may perform network calls, once
+			// the context is cancelled or its deadline is exceeded.
+			if ctx.Err() != nil {
+				resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+				return
+			}
+
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
 			validateResp := &validator.ObjectResponse{}
@@ -425,29 +557,61 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	attributes := o.GetAttributes()
+
+	for _, nestedName := range sortedAttributeNames(attributes) {
+		nestedAttr := attributes[nestedName]
 		nestedAttrReq := ValidateAttributeRequest{
-			AttributePath:           req.AttributePath.AtName(nestedName),
-			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
-			Config:                  req.Config,
+			AttributePath:                 req.AttributePath.AtName(nestedName),
+			AttributePathExpression:       req.AttributePathExpression.AtName(nestedName),
+			Config:                        req.Config,
+			SuppressedWarningPaths:        req.SuppressedWarningPaths,
+			ParentBlockDeprecationMessage: req.ParentBlockDeprecationMessage,
+			DiagnosticSink:                req.DiagnosticSink,
+			validatorCache:                req.validatorCache,
 		}
 		nestedAttrResp := &ValidateAttributeResponse{}
 
+		sender.send(ctx)
+
 		AttributeValidate(ctx, nestedAttr, nestedAttrReq, nestedAttrResp)
 
-		resp.Diagnostics.Append(nestedAttrResp.Diagnostics...)
+		sender.appendSent(nestedAttrResp.Diagnostics...)
 	}
 
-	for nestedName, nestedBlock := range o.GetBlocks() {
+	blocks := o.GetBlocks()
+
+	for _, nestedName := range sortedBlockNames(blocks) {
+		nestedBlock := blocks[nestedName]
 		nestedBlockReq := ValidateAttributeRequest{
-			AttributePath:           req.AttributePath.AtName(nestedName),
-			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
-			Config:                  req.Config,
+			AttributePath:                 req.AttributePath.AtName(nestedName),
+			AttributePathExpression:       req.AttributePathExpression.AtName(nestedName),
+			Config:                        req.Config,
+			SuppressedWarningPaths:        req.SuppressedWarningPaths,
+			ParentBlockDeprecationMessage: req.ParentBlockDeprecationMessage,
+			DiagnosticSink:                req.DiagnosticSink,
+			validatorCache:                req.validatorCache,
 		}
 		nestedBlockResp := &ValidateAttributeResponse{}
 
+		sender.send(ctx)
+
 		BlockValidate(ctx, nestedBlock, nestedBlockReq, nestedBlockResp)
 
-		resp.Diagnostics.Append(nestedBlockResp.Diagnostics...)
+		sender.appendSent(nestedBlockResp.Diagnostics...)
+	}
+}
+
+// sortedBlockNames returns the names of the given blocks in sorted order, so
+// validation diagnostics are consistently ordered.
+func sortedBlockNames(blocks map[string]fwschema.Block) []string {
+	names := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		names = append(names, name)
 	}
+
+	sort.Strings(names)
+
+	return names
 }
@@ -95,6 +95,354 @@ func TestBlockValidate(t *testing.T) {
 				},
 			},
 		},
+		"deprecation-message-empty": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Blocks: map[string]fwschema.Block{
+							"test": testschema.Block{
+								NestedObject: testschema.NestedBlockObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.Attribute{
+											Type:     types.StringType,
+											Optional: true,
+										},
+									},
+								},
+								DeprecationMessage: "Use something else instead.",
+								NestingMode:        fwschema.BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"deprecation-message-multiple-elements-nested-deprecation": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue1"),
+										},
+									),
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue2"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Blocks: map[string]fwschema.Block{
+							"test": testschema.Block{
+								NestedObject: testschema.NestedBlockObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.Attribute{
+											DeprecationMessage: "Use something else instead.",
+											Type:               types.StringType,
+											Optional:           true,
+										},
+									},
+								},
+								DeprecationMessage: "Use something else instead.",
+								NestingMode:        fwschema.BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Block Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
+		"deprecation-message-nested-different-message": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Blocks: map[string]fwschema.Block{
+							"test": testschema.Block{
+								NestedObject: testschema.NestedBlockObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.Attribute{
+											DeprecationMessage: "Use other_attr instead.",
+											Type:               types.StringType,
+											Optional:           true,
+										},
+									},
+								},
+								DeprecationMessage: "Use something else instead.",
+								NestingMode:        fwschema.BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					// Nested deprecation warnings with a different message
+					// are not redundant with the block deprecation warning.
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("nested_attr"),
+						"Attribute Deprecated",
+						"Use other_attr instead.",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Block Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
+		"deprecation-message-nested-validator-warning": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Blocks: map[string]fwschema.Block{
+							"test": testschema.Block{
+								NestedObject: testschema.NestedBlockObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.AttributeWithStringValidators{
+											Optional: true,
+											Validators: []validator.String{
+												testvalidator.String{
+													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+														resp.Diagnostics.AddAttributeWarning(req.Path, "Attribute Deprecated", "Provider validator warning.")
+													},
+												},
+											},
+										},
+									},
+								},
+								DeprecationMessage: "Use something else instead.",
+								NestingMode:        fwschema.BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					// Provider validator warnings are never removed, even
+					// with the same summary as framework warnings.
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("nested_attr"),
+						"Attribute Deprecated",
+						"Provider validator warning.",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Block Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
+		"deprecation-message-nested-suppressed": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Blocks: map[string]fwschema.Block{
+							"test": testschema.Block{
+								NestedObject: testschema.NestedBlockObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.Attribute{
+											Type:               types.StringType,
+											Required:           true,
+											DeprecationMessage: "Use something else instead.",
+										},
+									},
+								},
+								NestingMode: fwschema.BlockNestingModeList,
+							},
+						},
+					},
+				},
+				SuppressedWarningPaths: path.Expressions{
+					path.MatchRoot("test").AtAnyListIndex().AtName("nested_attr"),
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
 		"deprecation-message-null": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -1921,6 +2269,97 @@ func TestBlockValidateSet(t *testing.T) {
 	}
 }
 
+func TestBlockValidateNestedBlocksOrdering(t *testing.T) {
+	t.Parallel()
+
+	nestedNames := []string{"a", "b", "c", "d", "e"}
+	nestedBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"attr": tftypes.String,
+		},
+	}
+	nestedBlockValue := tftypes.NewValue(nestedBlockType, map[string]tftypes.Value{
+		"attr": tftypes.NewValue(tftypes.String, "read-only"),
+	})
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{},
+	}
+	objectValues := map[string]tftypes.Value{}
+	nestedBlocks := map[string]fwschema.Block{}
+
+	for _, name := range nestedNames {
+		objectType.AttributeTypes[name] = nestedBlockType
+		objectValues[name] = nestedBlockValue
+		nestedBlocks[name] = testschema.Block{
+			NestedObject: testschema.NestedBlockObject{
+				Attributes: map[string]fwschema.Attribute{
+					"attr": testschema.Attribute{
+						Computed: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			NestingMode: fwschema.BlockNestingModeSingle,
+		}
+	}
+
+	req := ValidateAttributeRequest{
+		AttributePath: path.Root("test"),
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{ElementType: objectType},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.List{ElementType: objectType},
+						[]tftypes.Value{
+							tftypes.NewValue(objectType, objectValues),
+						},
+					),
+				},
+			),
+			Schema: testschema.Schema{
+				Blocks: map[string]fwschema.Block{
+					"test": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Blocks: nestedBlocks,
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+				},
+			},
+		},
+	}
+
+	var expected diag.Diagnostics
+
+	for _, nestedName := range nestedNames {
+		expected.AddAttributeError(
+			path.Root("test").AtListIndex(0).AtName(nestedName).AtName("attr"),
+			"Invalid Configuration for Read-Only Attribute",
+			"Cannot set value for this attribute as the provider has marked it as read-only. Remove the configuration line setting the value.\n\n"+
+				"Refer to the provider documentation or contact the provider developers for additional information about configurable and read-only attributes that are supported.",
+		)
+	}
+
+	block := req.Config.Schema.GetBlocks()["test"]
+
+	// Map iteration is randomized, so repeated validation would eventually
+	// return a different ordering if not sorted.
+	for i := 0; i < 20; i++ {
+		var got ValidateAttributeResponse
+
+		BlockValidate(context.Background(), block, req, &got)
+
+		if diff := cmp.Diff(got.Diagnostics, expected); diff != "" {
+			t.Fatalf("Unexpected diagnostics on run %d (+wanted, -got): %s", i, diff)
+		}
+	}
+}
+
 func TestNestedBlockObjectValidateObject(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,150 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// DiagnosticSink receives diagnostics as they are produced during attribute
+// and block validation, rather than once validation of the whole configuration is
+// complete. This enables streaming diagnostics of large configurations, such
+// as to logs or progress reporting. Diagnostics are still returned in the
+// validation response. The configuration validation RPCs set the
+// DiagnosticSink of providers implementing the
+// provider.ProviderWithValidationDiagnostics interface.
+type DiagnosticSink interface {
+	// ReceiveDiagnostic is called once with each diagnostic, in the same
+	// order the diagnostics appear in the validation response.
+	ReceiveDiagnostic(context.Context, diag.Diagnostic)
+}
+
+// providerDiagnosticSink is a DiagnosticSink which sends diagnostics to a
+// provider implementing the ProviderWithValidationDiagnostics interface.
+type providerDiagnosticSink struct {
+	provider provider.ProviderWithValidationDiagnostics
+}
+
+// ReceiveDiagnostic sends the diagnostic to the provider.
+func (s providerDiagnosticSink) ReceiveDiagnostic(ctx context.Context, d diag.Diagnostic) {
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider ValidationDiagnostic")
+	s.provider.ValidationDiagnostic(ctx, d)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider ValidationDiagnostic")
+}
+
+// uniqueDiagnosticSink is a DiagnosticSink which only sends diagnostics to
+// the underlying DiagnosticSink if an equal diagnostic was not already sent.
+// This matches the diag.Diagnostics Append method, which is used to collect
+// nested validation diagnostics into the validation response.
+type uniqueDiagnosticSink struct {
+	sent map[uniqueDiagnosticKey]struct{}
+	sink DiagnosticSink
+}
+
+// uniqueDiagnosticKey identifies equal diagnostics, so large configurations
+// do not require comparing each diagnostic with every sent diagnostic.
+type uniqueDiagnosticKey struct {
+	severity diag.Severity
+	summary  string
+	detail   string
+	withPath bool
+	path     string
+}
+
+// newUniqueDiagnosticKey returns the uniqueDiagnosticKey of the diagnostic.
+func newUniqueDiagnosticKey(d diag.Diagnostic) uniqueDiagnosticKey {
+	key := uniqueDiagnosticKey{
+		severity: d.Severity(),
+		summary:  d.Summary(),
+		detail:   d.Detail(),
+	}
+
+	if dWithPath, ok := d.(diag.DiagnosticWithPath); ok {
+		key.withPath = true
+		key.path = dWithPath.Path().String()
+	}
+
+	return key
+}
+
+// newUniqueDiagnosticSink returns the DiagnosticSink wrapped so each unique
+// diagnostic is sent once. A nil DiagnosticSink, or one which is already
+// wrapped, is returned as-is.
+func newUniqueDiagnosticSink(sink DiagnosticSink) DiagnosticSink {
+	if sink == nil {
+		return nil
+	}
+
+	if _, ok := sink.(*uniqueDiagnosticSink); ok {
+		return sink
+	}
+
+	return &uniqueDiagnosticSink{
+		sent: make(map[uniqueDiagnosticKey]struct{}),
+		sink: sink,
+	}
+}
+
+// ReceiveDiagnostic sends the diagnostic to the underlying DiagnosticSink,
+// unless an equal diagnostic was already sent.
+func (s *uniqueDiagnosticSink) ReceiveDiagnostic(ctx context.Context, d diag.Diagnostic) {
+	if d == nil {
+		return
+	}
+
+	key := newUniqueDiagnosticKey(d)
+
+	if _, ok := s.sent[key]; ok {
+		return
+	}
+
+	s.sent[key] = struct{}{}
+	s.sink.ReceiveDiagnostic(ctx, d)
+}
+
+// diagnosticSender sends the diagnostics of a validation response to the
+// DiagnosticSink of the request, in response order. Diagnostics already in
+// the response when the diagnosticSender is created are not sent.
+type diagnosticSender struct {
+	resp *ValidateAttributeResponse
+	sent int
+	sink DiagnosticSink
+}
+
+// newDiagnosticSender returns a diagnosticSender for the response, which
+// sends to the DiagnosticSink of the request.
+func (r ValidateAttributeRequest) newDiagnosticSender(resp *ValidateAttributeResponse) *diagnosticSender {
+	return &diagnosticSender{
+		resp: resp,
+		sent: len(resp.Diagnostics),
+		sink: r.DiagnosticSink,
+	}
+}
+
+// send sends the diagnostics appended to the response since the last call.
+// Nested validation sends its own diagnostics, so this must be called before
+// nested validation to preserve the response ordering.
+func (s *diagnosticSender) send(ctx context.Context) {
+	if s.sink != nil {
+		for _, d := range s.resp.Diagnostics[s.sent:] {
+			s.sink.ReceiveDiagnostic(ctx, d)
+		}
+	}
+
+	s.markSent()
+}
+
+// appendSent appends the diagnostics of nested validation, which were
+// already sent, to the response.
+func (s *diagnosticSender) appendSent(diags ...diag.Diagnostic) {
+	s.resp.Diagnostics.Append(diags...)
+	s.markSent()
+}
+
+// markSent records all diagnostics in the response as sent, such as after
+// nested validation which appends to the response directly.
+func (s *diagnosticSender) markSent() {
+	s.sent = len(s.resp.Diagnostics)
+}
@@ -0,0 +1,435 @@
+package fwserver
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testDiagnosticSink is a DiagnosticSink which records received diagnostics.
+type testDiagnosticSink struct {
+	received diag.Diagnostics
+}
+
+func (s *testDiagnosticSink) ReceiveDiagnostic(_ context.Context, d diag.Diagnostic) {
+	s.received = append(s.received, d)
+}
+
+// testDiagnosticSinkConfig returns a configuration with a list nested
+// attribute of objects with the given name attribute values.
+func testDiagnosticSinkConfig(list fwschema.Attribute, names ...interface{}) tfsdk.Config {
+	return tfsdk.Config{
+		Raw: testDiagnosticSinkValue(names...),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list":  list,
+				"other": testDiagnosticSinkOtherAttribute(),
+			},
+		},
+	}
+}
+
+// testDiagnosticSinkBlockConfig returns a configuration with a list nested
+// block of objects with the given name attribute values.
+func testDiagnosticSinkBlockConfig(list fwschema.Block, names ...interface{}) tfsdk.Config {
+	return tfsdk.Config{
+		Raw: testDiagnosticSinkValue(names...),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"other": testDiagnosticSinkOtherAttribute(),
+			},
+			Blocks: map[string]fwschema.Block{
+				"list": list,
+			},
+		},
+	}
+}
+
+// testDiagnosticSinkValue returns a configuration value with a list of
+// objects with the given name attribute values.
+func testDiagnosticSinkValue(names ...interface{}) tftypes.Value {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	elements := make([]tftypes.Value, 0, len(names))
+
+	for _, name := range names {
+		elements = append(elements, tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		}))
+	}
+
+	return tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list":  tftypes.List{ElementType: objectType},
+				"other": tftypes.String,
+			},
+		},
+		map[string]tftypes.Value{
+			"list":  tftypes.NewValue(tftypes.List{ElementType: objectType}, elements),
+			"other": tftypes.NewValue(tftypes.String, "invalid"),
+		},
+	)
+}
+
+// testDiagnosticSinkOtherAttribute returns an attribute which raises a
+// warning for every value. Warnings do not prevent the validation of other
+// attributes, so diagnostics are consistent regardless of the order in which
+// attributes are validated.
+func testDiagnosticSinkOtherAttribute() fwschema.Attribute {
+	return testschema.AttributeWithStringValidators{
+		Optional: true,
+		Validators: []validator.String{
+			testvalidator.String{
+				ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+					resp.Diagnostics.AddAttributeWarning(req.Path, "Other Warning", "Value is checked.")
+				},
+			},
+		},
+	}
+}
+
+// testDiagnosticSinkListAttribute returns a list nested attribute with a
+// name attribute using the given validators.
+func testDiagnosticSinkListAttribute(deprecationMessage string, validators ...validator.String) fwschema.Attribute {
+	return testschema.NestedAttribute{
+		DeprecationMessage: deprecationMessage,
+		NestedObject: testschema.NestedAttributeObject{
+			Attributes: map[string]fwschema.Attribute{
+				"name": testschema.AttributeWithStringValidators{
+					Optional:   true,
+					Validators: validators,
+				},
+			},
+		},
+		NestingMode: fwschema.NestingModeList,
+		Optional:    true,
+	}
+}
+
+// testDiagnosticSinkListBlock returns a list nested block with a name
+// attribute using the given validators.
+func testDiagnosticSinkListBlock(deprecationMessage string, validators ...validator.String) fwschema.Block {
+	return testschema.Block{
+		DeprecationMessage: deprecationMessage,
+		NestedObject: testschema.NestedBlockObject{
+			Attributes: map[string]fwschema.Attribute{
+				"name": testschema.AttributeWithStringValidators{
+					Optional:   true,
+					Validators: validators,
+				},
+			},
+		},
+		NestingMode: fwschema.BlockNestingModeList,
+	}
+}
+
+// testDiagnosticSinkValidator returns a validator which raises an error for
+// "invalid" values. If a sink is given, the error detail includes the number
+// of diagnostics the sink received before the validator was called.
+func testDiagnosticSinkValidator(sink *testDiagnosticSink) testvalidator.String {
+	return testvalidator.String{
+		ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.ValueString() != "invalid" {
+				return
+			}
+
+			detail := "Value must not be invalid."
+
+			if sink != nil {
+				detail += " Received: " + strconv.Itoa(len(sink.received))
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Value", detail)
+		},
+	}
+}
+
+// testDiagnosticSinkListValidator returns a validator which raises the same
+// warning at the list attribute path for every value.
+func testDiagnosticSinkListValidator() testvalidator.String {
+	return testvalidator.String{
+		ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("list"), "List Warning", "Value is checked.")
+		},
+	}
+}
+
+func TestAttributeValidateDiagnosticSink(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		list          func(*testDiagnosticSink) fwschema.Attribute
+		names         []interface{}
+		expectedDiags diag.Diagnostics
+	}{
+		"no-diagnostics": {
+			list: func(sink *testDiagnosticSink) fwschema.Attribute {
+				return testDiagnosticSinkListAttribute("", testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"valid", "valid"},
+		},
+		"nested-attribute-diagnostics": {
+			list: func(sink *testDiagnosticSink) fwschema.Attribute {
+				return testDiagnosticSinkListAttribute("", testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"invalid", "valid", "invalid"},
+			expectedDiags: diag.Diagnostics{
+				// The second error shows the first error was already
+				// received when its validator was called.
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(0).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 0"),
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(2).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 1"),
+			},
+		},
+		"duplicate-diagnostics": {
+			list: func(sink *testDiagnosticSink) fwschema.Attribute {
+				return testDiagnosticSinkListAttribute("", testDiagnosticSinkListValidator(), testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"valid", "invalid", "valid"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("list"), "List Warning", "Value is checked."),
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(1).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 1"),
+			},
+		},
+		"deprecated": {
+			list: func(sink *testDiagnosticSink) fwschema.Attribute {
+				return testDiagnosticSinkListAttribute("Use something else.", testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"invalid"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(0).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 0"),
+				diag.NewAttributeWarningDiagnostic(path.Root("list"), "Attribute Deprecated", "Use something else."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sink := &testDiagnosticSink{}
+			list := testCase.list(sink)
+
+			req := ValidateAttributeRequest{
+				AttributePath:           path.Root("list"),
+				AttributePathExpression: path.MatchRoot("list"),
+				Config:                  testDiagnosticSinkConfig(list, testCase.names...),
+				DiagnosticSink:          sink,
+			}
+			resp := &ValidateAttributeResponse{}
+
+			AttributeValidate(context.Background(), list, req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected response diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(sink.received, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected received diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeValidateDiagnosticSinkNil(t *testing.T) {
+	t.Parallel()
+
+	list := testDiagnosticSinkListAttribute("", testDiagnosticSinkValidator(nil))
+
+	req := ValidateAttributeRequest{
+		AttributePath:           path.Root("list"),
+		AttributePathExpression: path.MatchRoot("list"),
+		Config:                  testDiagnosticSinkConfig(list, "invalid"),
+	}
+	resp := &ValidateAttributeResponse{}
+
+	AttributeValidate(context.Background(), list, req, resp)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(0).AtName("name"), "Invalid Value", "Value must not be invalid."),
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("unexpected response diagnostics difference: %s", diff)
+	}
+}
+
+func TestBlockValidateDiagnosticSink(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		list          func(*testDiagnosticSink) fwschema.Block
+		names         []interface{}
+		expectedDiags diag.Diagnostics
+	}{
+		"no-diagnostics": {
+			list: func(sink *testDiagnosticSink) fwschema.Block {
+				return testDiagnosticSinkListBlock("", testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"valid", "valid"},
+		},
+		"nested-attribute-diagnostics": {
+			list: func(sink *testDiagnosticSink) fwschema.Block {
+				return testDiagnosticSinkListBlock("", testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"invalid", "valid", "invalid"},
+			expectedDiags: diag.Diagnostics{
+				// The second error shows the first error was already
+				// received when its validator was called.
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(0).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 0"),
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(2).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 1"),
+			},
+		},
+		"duplicate-diagnostics": {
+			list: func(sink *testDiagnosticSink) fwschema.Block {
+				return testDiagnosticSinkListBlock("", testDiagnosticSinkListValidator(), testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"valid", "invalid", "valid"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("list"), "List Warning", "Value is checked."),
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(1).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 1"),
+			},
+		},
+		"deprecated": {
+			list: func(sink *testDiagnosticSink) fwschema.Block {
+				return testDiagnosticSinkListBlock("Use something else.", testDiagnosticSinkValidator(sink))
+			},
+			names: []interface{}{"invalid"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(0).AtName("name"), "Invalid Value", "Value must not be invalid. Received: 0"),
+				diag.NewAttributeWarningDiagnostic(path.Root("list"), "Block Deprecated", "Use something else."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sink := &testDiagnosticSink{}
+			list := testCase.list(sink)
+
+			req := ValidateAttributeRequest{
+				AttributePath:           path.Root("list"),
+				AttributePathExpression: path.MatchRoot("list"),
+				Config:                  testDiagnosticSinkBlockConfig(list, testCase.names...),
+				DiagnosticSink:          sink,
+			}
+			resp := &ValidateAttributeResponse{}
+
+			BlockValidate(context.Background(), list, req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected response diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(sink.received, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected received diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateDiagnosticSink(t *testing.T) {
+	t.Parallel()
+
+	sink := &testDiagnosticSink{}
+	config := testDiagnosticSinkConfig(
+		testDiagnosticSinkListAttribute("", testDiagnosticSinkListValidator(), testDiagnosticSinkValidator(nil)),
+		"invalid", "invalid",
+	)
+
+	req := ValidateSchemaRequest{
+		Config:         config,
+		DiagnosticSink: sink,
+	}
+	resp := &ValidateSchemaResponse{}
+
+	SchemaValidate(context.Background(), config.Schema, req, resp)
+
+	// Attributes are validated in any order, so only the count is
+	// consistent across runs.
+	if len(resp.Diagnostics) != 4 {
+		t.Errorf("expected 4 response diagnostics, got %d: %v", len(resp.Diagnostics), resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(sink.received, resp.Diagnostics); diff != "" {
+		t.Errorf("unexpected received diagnostics difference: %s", diff)
+	}
+}
+
+func TestSchemaValidateDiagnosticSinkBlocks(t *testing.T) {
+	t.Parallel()
+
+	sink := &testDiagnosticSink{}
+	config := testDiagnosticSinkBlockConfig(
+		testDiagnosticSinkListBlock("", testDiagnosticSinkListValidator(), testDiagnosticSinkValidator(nil)),
+		"invalid", "invalid",
+	)
+
+	req := ValidateSchemaRequest{
+		Config:         config,
+		DiagnosticSink: sink,
+	}
+	resp := &ValidateSchemaResponse{}
+
+	SchemaValidate(context.Background(), config.Schema, req, resp)
+
+	if len(resp.Diagnostics) != 4 {
+		t.Errorf("expected 4 response diagnostics, got %d: %v", len(resp.Diagnostics), resp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(sink.received, resp.Diagnostics); diff != "" {
+		t.Errorf("unexpected received diagnostics difference: %s", diff)
+	}
+}
+
+func TestSchemaValidateDiagnosticSinkDeprecated(t *testing.T) {
+	t.Parallel()
+
+	sink := &testDiagnosticSink{}
+	config := testDiagnosticSinkConfig(testDiagnosticSinkListAttribute(""), "valid")
+	config.Schema = testschema.Schema{
+		Attributes:         config.Schema.GetAttributes(),
+		DeprecationMessage: "Use something else.",
+	}
+
+	req := ValidateSchemaRequest{
+		Config:         config,
+		DiagnosticSink: sink,
+	}
+	resp := &ValidateSchemaResponse{}
+
+	SchemaValidate(context.Background(), config.Schema, req, resp)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeWarningDiagnostic(path.Root("other"), "Other Warning", "Value is checked."),
+		diag.NewWarningDiagnostic("Deprecated", "Use something else."),
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("unexpected response diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(sink.received, expectedDiags); diff != "" {
+		t.Errorf("unexpected received diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,27 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// restoreAliases returns the given value with each attribute value moved
+// into the alias which is configured in the reference value. Provider data
+// models only include the attribute names which declared any aliases, so
+// values set by the provider must be moved back before responding to
+// Terraform, which expects the values under the configured names.
+func restoreAliases(ctx context.Context, description fwschemadata.DataDescription, s fwschema.Schema, reference tftypes.Value, value tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	data := &fwschemadata.Data{
+		Description:    description,
+		Schema:         s,
+		TerraformValue: value,
+	}
+
+	diags := data.RestoreAliases(ctx, reference)
+
+	return data.TerraformValue, diags
+}
@@ -0,0 +1,249 @@
+package fwserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// SchemaValidateOnApply calls all Attribute and Block validators which
+// implement validator.OnApply with the configuration, which is fully known
+// during resource apply. Other validation, such as Required attribute
+// checks and deprecation warnings, is not performed again since it was
+// already performed during configuration validation.
+func SchemaValidateOnApply(ctx context.Context, s fwschema.Schema, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
+	configData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         req.Config.Schema,
+		TerraformValue: req.Config.Raw,
+	}
+
+	validateOnApplyAttributes(ctx, s.GetAttributes(), path.Empty(), configData, req, resp)
+	validateOnApplyBlocks(ctx, s.GetBlocks(), path.Empty(), configData, req, resp)
+}
+
+// validateOnApplyAttributes calls the validator.OnApply validators of the
+// given attributes underneath the parent path and of any nested attributes.
+func validateOnApplyAttributes(ctx context.Context, attributes map[string]fwschema.Attribute, parentPath path.Path, configData *fwschemadata.Data, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
+	for _, name := range sortedAttributeNames(attributes) {
+		a := attributes[name]
+		attributePath := parentPath.AtName(name)
+		attributeCtx := logging.FrameworkWithAttributePath(ctx, attributePath.String())
+
+		attributeConfig, diags := configData.ValueAtPath(attributeCtx, attributePath)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		if hasOnApplyValidators(fwxschema.AttributeValidators(a)) {
+			attributeReq := ValidateAttributeRequest{
+				AttributeConfig:         attributeConfig,
+				AttributePath:           attributePath,
+				AttributePathExpression: attributePath.Expression(),
+				Config:                  req.Config,
+				OnApply:                 true,
+			}
+			attributeResp := &ValidateAttributeResponse{}
+
+			AttributeValidateValidators(attributeCtx, a, attributeReq, attributeResp)
+
+			resp.Diagnostics.Append(attributeResp.Diagnostics...)
+		}
+
+		nestedAttribute, ok := a.(fwschema.NestedAttribute)
+
+		if !ok {
+			continue
+		}
+
+		var elementPaths path.Paths
+
+		switch nestedAttribute.GetNestingMode() {
+		case fwschema.NestingModeList:
+			elementPaths = validateOnApplyListElementPaths(attributeCtx, attributeConfig, attributePath, resp)
+		case fwschema.NestingModeMap:
+			elementPaths = validateOnApplyMapElementPaths(attributeCtx, attributeConfig, attributePath, resp)
+		case fwschema.NestingModeSet:
+			elementPaths = validateOnApplySetElementPaths(attributeCtx, attributeConfig, attributePath, resp)
+		case fwschema.NestingModeSingle:
+			if !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
+				elementPaths = path.Paths{attributePath}
+			}
+		}
+
+		for _, elementPath := range elementPaths {
+			validateOnApplyAttributes(attributeCtx, nestedAttribute.GetNestedObject().GetAttributes(), elementPath, configData, req, resp)
+		}
+	}
+}
+
+// validateOnApplyBlocks calls the validator.OnApply validators of the given
+// blocks underneath the parent path and of any nested attributes and blocks.
+func validateOnApplyBlocks(ctx context.Context, blocks map[string]fwschema.Block, parentPath path.Path, configData *fwschemadata.Data, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
+	names := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		b := blocks[name]
+		blockPath := parentPath.AtName(name)
+		blockCtx := logging.FrameworkWithAttributePath(ctx, blockPath.String())
+
+		blockConfig, diags := configData.ValueAtPath(blockCtx, blockPath)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		if hasOnApplyValidators(fwxschema.BlockValidators(b)) {
+			blockReq := ValidateAttributeRequest{
+				AttributeConfig:         blockConfig,
+				AttributePath:           blockPath,
+				AttributePathExpression: blockPath.Expression(),
+				Config:                  req.Config,
+				OnApply:                 true,
+			}
+			blockResp := &ValidateAttributeResponse{}
+
+			BlockValidateValidators(blockCtx, b, blockReq, blockResp)
+
+			resp.Diagnostics.Append(blockResp.Diagnostics...)
+		}
+
+		var elementPaths path.Paths
+
+		switch b.GetNestingMode() {
+		case fwschema.BlockNestingModeList:
+			elementPaths = validateOnApplyListElementPaths(blockCtx, blockConfig, blockPath, resp)
+		case fwschema.BlockNestingModeSet:
+			elementPaths = validateOnApplySetElementPaths(blockCtx, blockConfig, blockPath, resp)
+		case fwschema.BlockNestingModeSingle:
+			if !blockConfig.IsNull() && !blockConfig.IsUnknown() {
+				elementPaths = path.Paths{blockPath}
+			}
+		}
+
+		nestedObject := b.GetNestedObject()
+
+		for _, elementPath := range elementPaths {
+			validateOnApplyAttributes(blockCtx, nestedObject.GetAttributes(), elementPath, configData, req, resp)
+			validateOnApplyBlocks(blockCtx, nestedObject.GetBlocks(), elementPath, configData, req, resp)
+		}
+	}
+}
+
+// hasOnApplyValidators returns true if any of the validators implement
+// validator.OnApply and should be called during resource apply.
+func hasOnApplyValidators(describers []validator.Describer) bool {
+	for _, describer := range describers {
+		if onApplyValidator, ok := describer.(validator.OnApply); ok && onApplyValidator.ValidateOnApply() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateOnApplyListElementPaths returns the paths of each element of the
+// list value at the given path. Null or unknown values have no element paths.
+func validateOnApplyListElementPaths(ctx context.Context, value attr.Value, p path.Path, resp *ValidateSchemaResponse) path.Paths {
+	listValuable, ok := value.(basetypes.ListValuable)
+
+	if !ok {
+		return nil
+	}
+
+	listValue, diags := listValuable.ToListValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || listValue.IsNull() || listValue.IsUnknown() {
+		return nil
+	}
+
+	elementPaths := make(path.Paths, 0, len(listValue.Elements()))
+
+	for idx := range listValue.Elements() {
+		elementPaths = append(elementPaths, p.AtListIndex(idx))
+	}
+
+	return elementPaths
+}
+
+// validateOnApplyMapElementPaths returns the paths of each element of the map
+// value at the given path, ordered by key. Null or unknown values have no
+// element paths.
+func validateOnApplyMapElementPaths(ctx context.Context, value attr.Value, p path.Path, resp *ValidateSchemaResponse) path.Paths {
+	mapValuable, ok := value.(basetypes.MapValuable)
+
+	if !ok {
+		return nil
+	}
+
+	mapValue, diags := mapValuable.ToMapValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || mapValue.IsNull() || mapValue.IsUnknown() {
+		return nil
+	}
+
+	keys := make([]string, 0, len(mapValue.Elements()))
+
+	for key := range mapValue.Elements() {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	elementPaths := make(path.Paths, 0, len(keys))
+
+	for _, key := range keys {
+		elementPaths = append(elementPaths, p.AtMapKey(key))
+	}
+
+	return elementPaths
+}
+
+// validateOnApplySetElementPaths returns the paths of each element of the set
+// value at the given path. Null or unknown values have no element paths.
+func validateOnApplySetElementPaths(ctx context.Context, value attr.Value, p path.Path, resp *ValidateSchemaResponse) path.Paths {
+	setValuable, ok := value.(basetypes.SetValuable)
+
+	if !ok {
+		return nil
+	}
+
+	setValue, diags := setValuable.ToSetValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || setValue.IsNull() || setValue.IsUnknown() {
+		return nil
+	}
+
+	elementPaths := make(path.Paths, 0, len(setValue.Elements()))
+
+	for _, element := range setValue.Elements() {
+		elementPaths = append(elementPaths, p.AtSetValue(element))
+	}
+
+	return elementPaths
+}
@@ -0,0 +1,316 @@
+package fwserver
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValidateSchemaUpdateRequest represents a request for validating the update
+// of a resource against a Schema.
+type ValidateSchemaUpdateRequest struct {
+	// Config contains the entire configuration of the resource.
+	Config tfsdk.Config
+
+	// Plan contains the entire planned new state of the resource, after all
+	// plan modification.
+	Plan tfsdk.Plan
+
+	// State contains the entire prior state of the resource.
+	State tfsdk.State
+}
+
+// ValidateSchemaUpdateResponse represents a response to a
+// ValidateSchemaUpdateRequest.
+type ValidateSchemaUpdateResponse struct {
+	// Diagnostics report errors or warnings related to validating the
+	// resource update. An empty slice indicates success, with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+}
+
+// SchemaValidateUpdate performs all validator.Update validation of Attribute
+// and Block validators. Callers are responsible for only calling this when
+// there is prior state and the plan is not null.
+//
+// Set nested attributes and blocks are not walked, since elements of a set
+// have no stable correspondence with the prior state.
+func SchemaValidateUpdate(ctx context.Context, s fwschema.Schema, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) {
+	validateUpdateAttributes(ctx, s.GetAttributes(), path.Empty(), req, resp)
+	validateUpdateBlocks(ctx, s.GetBlocks(), path.Empty(), req, resp)
+}
+
+// validateUpdateAttributes performs validator.Update validation for the
+// given attributes underneath the parent path.
+func validateUpdateAttributes(ctx context.Context, attributes map[string]fwschema.Attribute, parentPath path.Path, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) {
+	for _, name := range sortedAttributeNames(attributes) {
+		attributeValidateUpdate(ctx, attributes[name], parentPath.AtName(name), req, resp)
+	}
+}
+
+// validateUpdateBlocks performs validator.Update validation for the given
+// blocks underneath the parent path.
+func validateUpdateBlocks(ctx context.Context, blocks map[string]fwschema.Block, parentPath path.Path, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) {
+	names := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		blockValidateUpdate(ctx, blocks[name], parentPath.AtName(name), req, resp)
+	}
+}
+
+// attributeValidateUpdate performs validator.Update validation for the
+// attribute and any nested attributes.
+func attributeValidateUpdate(ctx context.Context, a fwschema.Attribute, attributePath path.Path, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) {
+	ctx = logging.FrameworkWithAttributePath(ctx, attributePath.String())
+
+	validateUpdateValidators(ctx, updateValidators(fwxschema.AttributeValidators(a)), attributePath, req, resp)
+
+	nestedAttribute, ok := a.(fwschema.NestedAttribute)
+
+	if !ok {
+		return
+	}
+
+	attributes := nestedAttribute.GetNestedObject().GetAttributes()
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeSingle:
+		validateUpdateAttributes(ctx, attributes, attributePath, req, resp)
+	case fwschema.NestingModeList:
+		for _, elementPath := range validateUpdateListElementPaths(ctx, attributePath, req, resp) {
+			validateUpdateAttributes(ctx, attributes, elementPath, req, resp)
+		}
+	case fwschema.NestingModeMap:
+		for _, elementPath := range validateUpdateMapElementPaths(ctx, attributePath, req, resp) {
+			validateUpdateAttributes(ctx, attributes, elementPath, req, resp)
+		}
+	default:
+		logging.FrameworkTrace(ctx, "Skipping update validation of nested attributes with unsupported nesting mode")
+	}
+}
+
+// blockValidateUpdate performs validator.Update validation for the block and
+// any nested attributes and blocks.
+func blockValidateUpdate(ctx context.Context, b fwschema.Block, blockPath path.Path, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) {
+	ctx = logging.FrameworkWithAttributePath(ctx, blockPath.String())
+
+	validateUpdateValidators(ctx, updateValidators(fwxschema.BlockValidators(b)), blockPath, req, resp)
+
+	nestedObject := b.GetNestedObject()
+
+	switch b.GetNestingMode() {
+	case fwschema.BlockNestingModeSingle:
+		validateUpdateAttributes(ctx, nestedObject.GetAttributes(), blockPath, req, resp)
+		validateUpdateBlocks(ctx, nestedObject.GetBlocks(), blockPath, req, resp)
+	case fwschema.BlockNestingModeList:
+		for _, elementPath := range validateUpdateListElementPaths(ctx, blockPath, req, resp) {
+			validateUpdateAttributes(ctx, nestedObject.GetAttributes(), elementPath, req, resp)
+			validateUpdateBlocks(ctx, nestedObject.GetBlocks(), elementPath, req, resp)
+		}
+	default:
+		logging.FrameworkTrace(ctx, "Skipping update validation of nested block with unsupported nesting mode")
+	}
+}
+
+// validateUpdateValidators calls each validator.Update with the
+// configuration, plan, and prior state values at the given path.
+func validateUpdateValidators(ctx context.Context, validators []validator.Update, p path.Path, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) {
+	if len(validators) == 0 {
+		return
+	}
+
+	configData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         req.Config.Schema,
+		TerraformValue: req.Config.Raw,
+	}
+
+	var diags diag.Diagnostics
+
+	configValue, configDiags := configData.ValueAtPath(ctx, p)
+
+	diags.Append(configDiags...)
+
+	planValue, planDiags := validateUpdatePlanValue(ctx, p, req)
+
+	diags.Append(planDiags...)
+
+	stateData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         req.State.Schema,
+		TerraformValue: req.State.Raw,
+	}
+
+	stateValue, stateDiags := stateData.ValueAtPath(ctx, p)
+
+	diags.Append(stateDiags...)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.UpdateRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           p,
+		PathExpression: p.Expression(),
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, updateValidator := range validators {
+		// Stop calling validators, which may perform network calls, once
+		// the context is cancelled or its deadline is exceeded.
+		if ctx.Err() != nil {
+			resp.Diagnostics.Append(validatorCancelledDiagnostic(ctx))
+
+			return
+		}
+
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.UpdateResponse{}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Calling provider defined validator.Update",
+			map[string]interface{}{
+				logging.KeyDescription: updateValidator.Description(ctx),
+			},
+		)
+
+		updateValidator.ValidateUpdate(ctx, validateReq, validateResp)
+
+		logging.FrameworkDebug(
+			ctx,
+			"Called provider defined validator.Update",
+			map[string]interface{}{
+				logging.KeyDescription: updateValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
+// validateUpdatePlanValue returns the planned value at the given path.
+func validateUpdatePlanValue(ctx context.Context, p path.Path, req ValidateSchemaUpdateRequest) (attr.Value, diag.Diagnostics) {
+	planData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionPlan,
+		Schema:         req.Plan.Schema,
+		TerraformValue: req.Plan.Raw,
+	}
+
+	return planData.ValueAtPath(ctx, p)
+}
+
+// validateUpdateListElementPaths returns the paths of each element of the
+// planned list value at the given path. Null or unknown values have no
+// element paths.
+func validateUpdateListElementPaths(ctx context.Context, p path.Path, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) path.Paths {
+	planValue, diags := validateUpdatePlanValue(ctx, p, req)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	listValuable, ok := planValue.(basetypes.ListValuable)
+
+	if !ok {
+		return nil
+	}
+
+	listValue, diags := listValuable.ToListValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || listValue.IsNull() || listValue.IsUnknown() {
+		return nil
+	}
+
+	elementPaths := make(path.Paths, 0, len(listValue.Elements()))
+
+	for idx := range listValue.Elements() {
+		elementPaths = append(elementPaths, p.AtListIndex(idx))
+	}
+
+	return elementPaths
+}
+
+// validateUpdateMapElementPaths returns the paths of each element of the
+// planned map value at the given path, ordered by key. Null or unknown values
+// have no element paths.
+func validateUpdateMapElementPaths(ctx context.Context, p path.Path, req ValidateSchemaUpdateRequest, resp *ValidateSchemaUpdateResponse) path.Paths {
+	planValue, diags := validateUpdatePlanValue(ctx, p, req)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	mapValuable, ok := planValue.(basetypes.MapValuable)
+
+	if !ok {
+		return nil
+	}
+
+	mapValue, diags := mapValuable.ToMapValue(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || mapValue.IsNull() || mapValue.IsUnknown() {
+		return nil
+	}
+
+	keys := make([]string, 0, len(mapValue.Elements()))
+
+	for key := range mapValue.Elements() {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	elementPaths := make(path.Paths, 0, len(keys))
+
+	for _, key := range keys {
+		elementPaths = append(elementPaths, p.AtMapKey(key))
+	}
+
+	return elementPaths
+}
+
+// updateValidators returns the validators which implement validator.Update.
+func updateValidators(describers []validator.Describer) []validator.Update {
+	var result []validator.Update
+
+	for _, describer := range describers {
+		if updateValidator, ok := describer.(validator.Update); ok {
+			result = append(result, updateValidator)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,182 @@
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaValidateUpdate(t *testing.T) {
+	t.Parallel()
+
+	testElementType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"count": tftypes.Number,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"count":      tftypes.Number,
+			"list":       tftypes.List{ElementType: testElementType},
+			"set":        tftypes.Set{ElementType: testElementType},
+			"list_block": tftypes.List{ElementType: testElementType},
+		},
+	}
+
+	testNestedAttributeObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"count": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.OnlyIncreases(),
+				},
+			},
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"count": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.OnlyIncreases(),
+				},
+			},
+			"list": schema.ListNestedAttribute{
+				NestedObject: testNestedAttributeObject,
+				Optional:     true,
+			},
+			"set": schema.SetNestedAttribute{
+				NestedObject: testNestedAttributeObject,
+				Optional:     true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"list_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"count": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.OnlyIncreases(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testElements := func(counts ...int64) []tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(counts))
+
+		for _, count := range counts {
+			elements = append(elements, tftypes.NewValue(testElementType, map[string]tftypes.Value{
+				"count": tftypes.NewValue(tftypes.Number, count),
+			}))
+		}
+
+		return elements
+	}
+
+	testValue := func(count int64, list []tftypes.Value, set []tftypes.Value, listBlock []tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"count":      tftypes.NewValue(tftypes.Number, count),
+			"list":       tftypes.NewValue(tftypes.List{ElementType: testElementType}, list),
+			"set":        tftypes.NewValue(tftypes.Set{ElementType: testElementType}, set),
+			"list_block": tftypes.NewValue(tftypes.List{ElementType: testElementType}, listBlock),
+		})
+	}
+
+	testCases := map[string]struct {
+		plan     tftypes.Value
+		state    tftypes.Value
+		expected ValidateSchemaUpdateResponse
+	}{
+		"increase": {
+			plan:     testValue(2, testElements(2, 2), testElements(2), testElements(2)),
+			state:    testValue(1, testElements(1, 1), testElements(1), testElements(1)),
+			expected: ValidateSchemaUpdateResponse{},
+		},
+		"decrease": {
+			plan:  testValue(1, testElements(1), nil, nil),
+			state: testValue(2, testElements(2), nil, nil),
+			expected: ValidateSchemaUpdateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("count"),
+						"Invalid Attribute Value",
+						"Attribute count value must not decrease from the prior value, got: 1, prior value: 2",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("list").AtListIndex(0).AtName("count"),
+						"Invalid Attribute Value",
+						"Attribute list[0].count value must not decrease from the prior value, got: 1, prior value: 2",
+					),
+				},
+			},
+		},
+		"decrease-list-block": {
+			plan:  testValue(1, nil, nil, testElements(2, 1)),
+			state: testValue(1, nil, nil, testElements(1, 2)),
+			expected: ValidateSchemaUpdateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("list_block").AtListIndex(1).AtName("count"),
+						"Invalid Attribute Value",
+						"Attribute list_block[1].count value must not decrease from the prior value, got: 1, prior value: 2",
+					),
+				},
+			},
+		},
+		"list-element-added": {
+			plan:     testValue(1, testElements(1, 1), nil, nil),
+			state:    testValue(1, testElements(1), nil, nil),
+			expected: ValidateSchemaUpdateResponse{},
+		},
+		"set-skipped": {
+			plan:     testValue(1, nil, testElements(1), nil),
+			state:    testValue(1, nil, testElements(2), nil),
+			expected: ValidateSchemaUpdateResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := ValidateSchemaUpdateRequest{
+				Config: tfsdk.Config{
+					Raw:    testCase.plan,
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testCase.plan,
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testCase.state,
+					Schema: testSchema,
+				},
+			}
+			got := ValidateSchemaUpdateResponse{}
+
+			SchemaValidateUpdate(context.Background(), testSchema, req, &got)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
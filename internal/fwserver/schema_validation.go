@@ -17,6 +17,16 @@ type ValidateSchemaRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// SuppressedWarningPaths contains the expressions of attributes and blocks
+	// which should not raise framework-generated warnings, such as deprecation
+	// warnings. Error diagnostics are never suppressed.
+	SuppressedWarningPaths path.Expressions
+
+	// DiagnosticSink, if set, is sent each diagnostic of the schema
+	// validation, including Attribute and Block validation, as it is
+	// produced.
+	DiagnosticSink DiagnosticSink
 }
 
 // ValidateSchemaResponse represents a response to a
@@ -34,12 +44,22 @@ type ValidateSchemaResponse struct {
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
+	// Memoized validator results are only valid for this request.
+	cache := newValidatorCache()
+
+	// Diagnostics already sent for one attribute are not sent again for
+	// another, since they are not duplicated in the response.
+	sink := newUniqueDiagnosticSink(req.DiagnosticSink)
+
 	for name, attribute := range s.GetAttributes() {
 
 		attributeReq := ValidateAttributeRequest{
 			AttributePath:           path.Root(name),
 			AttributePathExpression: path.MatchRoot(name),
 			Config:                  req.Config,
+			SuppressedWarningPaths:  req.SuppressedWarningPaths,
+			DiagnosticSink:          sink,
+			validatorCache:          cache,
 		}
 		attributeResp := &ValidateAttributeResponse{
 			Diagnostics: resp.Diagnostics,
@@ -55,6 +75,9 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 			AttributePath:           path.Root(name),
 			AttributePathExpression: path.MatchRoot(name),
 			Config:                  req.Config,
+			SuppressedWarningPaths:  req.SuppressedWarningPaths,
+			DiagnosticSink:          sink,
+			validatorCache:          cache,
 		}
 		attributeResp := &ValidateAttributeResponse{
 			Diagnostics: resp.Diagnostics,
@@ -66,9 +89,15 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 	}
 
 	if s.GetDeprecationMessage() != "" {
-		resp.Diagnostics.AddWarning(
+		deprecationDiag := diag.NewWarningDiagnostic(
 			"Deprecated",
 			s.GetDeprecationMessage(),
 		)
+
+		resp.Diagnostics.Append(deprecationDiag)
+
+		if sink != nil {
+			sink.ReceiveDiagnostic(ctx, deprecationDiag)
+		}
 	}
 }
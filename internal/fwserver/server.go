@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -315,6 +316,61 @@ func (s *Server) ProviderMetaSchema(ctx context.Context) (fwschema.Schema, diag.
 	return s.providerMetaSchema, s.providerMetaSchemaDiags
 }
 
+// SuppressedWarningPaths returns the path expressions of provider
+// configuration attributes and blocks which should not raise
+// framework-generated validation warnings, if the Provider implements the
+// ProviderWithSuppressedWarningPaths interface.
+func (s *Server) SuppressedWarningPaths(ctx context.Context) path.Expressions {
+	providerWithSuppressedWarningPaths, ok := s.Provider.(provider.ProviderWithSuppressedWarningPaths)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithSuppressedWarningPaths")
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider SuppressedWarningPaths")
+	suppressedWarningPaths := providerWithSuppressedWarningPaths.SuppressedWarningPaths(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider SuppressedWarningPaths")
+
+	return suppressedWarningPaths
+}
+
+// AggregateWarnings returns the diagnostics with repeated warnings collapsed
+// into a single warning listing the affected paths, if the Provider
+// implements the ProviderWithAggregatedWarnings interface. Otherwise the
+// diagnostics are returned unmodified.
+func (s *Server) AggregateWarnings(ctx context.Context, diags diag.Diagnostics) diag.Diagnostics {
+	providerWithAggregatedWarnings, ok := s.Provider.(provider.ProviderWithAggregatedWarnings)
+
+	if !ok {
+		return diags
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithAggregatedWarnings")
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider AggregatedWarningsMaxPaths")
+	maxPaths := providerWithAggregatedWarnings.AggregatedWarningsMaxPaths(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider AggregatedWarningsMaxPaths")
+
+	return diags.AggregateWarnings(maxPaths)
+}
+
+// DiagnosticSink returns a DiagnosticSink which sends schema validation
+// diagnostics to the Provider, if it implements the
+// ProviderWithValidationDiagnostics interface. Otherwise nil is returned.
+func (s *Server) DiagnosticSink(ctx context.Context) DiagnosticSink {
+	providerWithValidationDiagnostics, ok := s.Provider.(provider.ProviderWithValidationDiagnostics)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithValidationDiagnostics")
+
+	return providerDiagnosticSink{
+		provider: providerWithValidationDiagnostics,
+	}
+}
+
 // Resource returns the Resource for a given type name.
 func (s *Server) Resource(ctx context.Context, typeName string) (resource.Resource, diag.Diagnostics) {
 	resourceFuncs, diags := s.ResourceFuncs(ctx)
@@ -37,6 +37,34 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		return
 	}
 
+	// Execute any validator.OnApply validators, which may have skipped
+	// unknown values during configuration validation, now that the
+	// configuration is fully known. This is skipped for resource destruction.
+	if req.Config != nil && req.PlannedState != nil && !req.PlannedState.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "ApplyResourceChange running validators on apply")
+
+		validateReq := ValidateSchemaRequest{
+			Config: *req.Config,
+		}
+		validateResp := &ValidateSchemaResponse{}
+
+		SchemaValidateOnApply(ctx, req.ResourceSchema, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			// Return the prior state of an existing resource, otherwise
+			// Terraform treats the missing new state as the resource being
+			// removed.
+			if req.PriorState != nil && !req.PriorState.Raw.IsNull() {
+				resp.NewState = req.PriorState
+				resp.Private = req.PlannedPrivate
+			}
+
+			return
+		}
+	}
+
 	// If PriorState is missing/null, its a Create request.
 	if req.PriorState == nil || req.PriorState.Raw.IsNull() {
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PriorState, running CreateResource")
@@ -53,7 +81,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 
 		s.CreateResource(ctx, createReq, createResp)
 
-		resp.Diagnostics = createResp.Diagnostics
+		resp.Diagnostics.Append(createResp.Diagnostics...)
 		resp.NewState = createResp.NewState
 		resp.Private = createResp.Private
 
@@ -98,7 +126,7 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 
 	s.UpdateResource(ctx, updateReq, updateResp)
 
-	resp.Diagnostics = updateResp.Diagnostics
+	resp.Diagnostics.Append(updateResp.Diagnostics...)
 	resp.NewState = updateResp.NewState
 	resp.Private = updateResp.Private
 }
@@ -13,9 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -106,6 +111,32 @@ func TestServerApplyResourceChange(t *testing.T) {
 		Provider: testProviderData,
 	}
 
+	testSchemaValidateOnApply := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					schemavalidator.ValidateOnApply(stringvalidator.HasPrefix("https://")),
+					testvalidator.String{
+						ValidateStringMethod: func(_ context.Context, _ validator.StringRequest, resp *validator.StringResponse) {
+							resp.Diagnostics.AddError("Unexpected Validator Call", "Only validators implementing validator.OnApply should be called during apply.")
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testValidateOnApplyValue := func(testRequired string) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, nil),
+			"test_required": tftypes.NewValue(tftypes.String, testRequired),
+		})
+	}
+
 	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
 
 	testEmptyPrivate := &privatestate.Data{
@@ -1255,6 +1286,142 @@ func TestServerApplyResourceChange(t *testing.T) {
 				Private: testPrivate,
 			},
 		},
+		"create-validateonapply-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ApplyResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testValidateOnApplyValue("http://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testValidateOnApplyValue("http://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchemaValidateOnApply,
+				},
+				ResourceSchema: testSchemaValidateOnApply,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: no call, Got: Create")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Invalid Attribute Value",
+						`Attribute test_required value must start with "https://", got: "http://example.com"`,
+					),
+				},
+			},
+		},
+		"create-validateonapply-valid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ApplyResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testValidateOnApplyValue("https://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testValidateOnApplyValue("https://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchemaValidateOnApply,
+				},
+				ResourceSchema: testSchemaValidateOnApply,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				NewState: &tfsdk.State{
+					Raw:    testValidateOnApplyValue("https://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"update-validateonapply-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ApplyResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testValidateOnApplyValue("http://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testValidateOnApplyValue("http://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testValidateOnApplyValue("https://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				ResourceSchema: testSchemaValidateOnApply,
+				Resource: &testprovider.Resource{
+					UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: no call, Got: Update")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Invalid Attribute Value",
+						`Attribute test_required value must start with "https://", got: "http://example.com"`,
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw:    testValidateOnApplyValue("https://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+			},
+		},
+		"delete-validateonapply-skipped": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ApplyResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchemaValidateOnApply,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchemaValidateOnApply,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testValidateOnApplyValue("http://example.com"),
+					Schema: testSchemaValidateOnApply,
+				},
+				ResourceSchema: testSchemaValidateOnApply,
+				Resource: &testprovider.Resource{
+					DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {},
+				},
+			},
+			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				NewState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchemaValidateOnApply,
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -100,6 +101,12 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 	resp.Diagnostics = createResp.Diagnostics
 	resp.NewState = &createResp.State
 
+	var diags diag.Diagnostics
+
+	resp.NewState.Raw, diags = restoreAliases(ctx, fwschemadata.DataDescriptionState, resp.NewState.Schema, createReq.Plan.Raw, resp.NewState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
 	if !resp.Diagnostics.HasError() && createResp.State.Raw.Equal(nullSchemaData) {
 		detail := "The Terraform Provider unexpectedly returned no resource state after having no errors in the resource creation. " +
 			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
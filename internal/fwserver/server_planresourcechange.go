@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -249,6 +250,33 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		resp.PlannedPrivate.Provider = modifyPlanResp.Private
 	}
 
+	var diags diag.Diagnostics
+
+	resp.PlannedState.Raw, diags = restoreAliases(ctx, fwschemadata.DataDescriptionPlan, req.ResourceSchema, req.Config.Raw, resp.PlannedState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Execute any validator.Update validators, which compare the prior state
+	// with the final plan.
+	//
+	// We only do this for updates; creation has no prior state and
+	// destruction has no plan.
+	if !resp.Diagnostics.HasError() && !req.PriorState.Raw.IsNull() && !resp.PlannedState.Raw.IsNull() {
+		validateSchemaUpdateReq := ValidateSchemaUpdateRequest{
+			Config: *req.Config,
+			Plan:   stateToPlan(*resp.PlannedState),
+			State:  *req.PriorState,
+		}
+
+		validateSchemaUpdateResp := ValidateSchemaUpdateResponse{
+			Diagnostics: resp.Diagnostics,
+		}
+
+		SchemaValidateUpdate(ctx, req.ResourceSchema, validateSchemaUpdateReq, &validateSchemaUpdateResp)
+
+		resp.Diagnostics = validateSchemaUpdateResp.Diagnostics
+	}
+
 	// Ensure deterministic RequiresReplace by sorting and deduplicating
 	resp.RequiresReplace = NormaliseRequiresReplace(ctx, resp.RequiresReplace)
 
@@ -20,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -464,6 +466,37 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaAttributePlanModifierPrivatePlanRequestAbsent := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.Private == nil {
+								resp.Diagnostics.AddError("unexpected req.Private value", "expected non-nil req.Private")
+
+								return
+							}
+
+							key := "providerKeyOne"
+							got, diags := req.Private.GetKey(ctx, key)
+
+							resp.Diagnostics.Append(diags...)
+
+							if got != nil {
+								resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+							}
+						},
+					},
+				},
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
 	testSchemaAttributePlanModifierPrivatePlanResponse := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -563,6 +596,29 @@ func TestServerPlanResourceChange(t *testing.T) {
 		Provider:  testProviderData,
 	}
 
+	testSchemaTypeValidateUpdate := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.Number,
+		},
+	}
+
+	testSchemaValidateUpdate := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.OnlyIncreases(),
+				},
+			},
+		},
+	}
+
+	testValidateUpdateValue := func(value int64) tftypes.Value {
+		return tftypes.NewValue(testSchemaTypeValidateUpdate, map[string]tftypes.Value{
+			"test_required": tftypes.NewValue(tftypes.Number, value),
+		})
+	}
+
 	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
 
 	testEmptyPrivate := &privatestate.Data{
@@ -704,6 +760,40 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testPrivate,
 			},
 		},
+		"create-attributeplanmodifier-request-privateplan-absent": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierPrivatePlanRequestAbsent,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierPrivatePlanRequestAbsent,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchemaAttributePlanModifierPrivatePlanRequestAbsent,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierPrivatePlanRequestAbsent,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-attributeplanmodifier-response-attributeplan": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -2503,6 +2593,97 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testPrivateProvider,
 			},
 		},
+		"create-validatorupdate-skipped": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testValidateUpdateValue(1),
+					Schema: testSchemaValidateUpdate,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testValidateUpdateValue(1),
+					Schema: testSchemaValidateUpdate,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaTypeValidateUpdate, nil),
+					Schema: testSchemaValidateUpdate,
+				},
+				ResourceSchema: testSchemaValidateUpdate,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testValidateUpdateValue(1),
+					Schema: testSchemaValidateUpdate,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-validatorupdate-increase": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testValidateUpdateValue(2),
+					Schema: testSchemaValidateUpdate,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testValidateUpdateValue(2),
+					Schema: testSchemaValidateUpdate,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testValidateUpdateValue(1),
+					Schema: testSchemaValidateUpdate,
+				},
+				ResourceSchema: testSchemaValidateUpdate,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testValidateUpdateValue(2),
+					Schema: testSchemaValidateUpdate,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-validatorupdate-decrease": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testValidateUpdateValue(1),
+					Schema: testSchemaValidateUpdate,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testValidateUpdateValue(1),
+					Schema: testSchemaValidateUpdate,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testValidateUpdateValue(2),
+					Schema: testSchemaValidateUpdate,
+				},
+				ResourceSchema: testSchemaValidateUpdate,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"Invalid Attribute Value",
+						"Attribute test_required value must not decrease from the prior value, got: 1, prior value: 2",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw:    testValidateUpdateValue(1),
+					Schema: testSchemaValidateUpdate,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -77,4 +78,10 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.State = &readResp.State
+
+	var diags diag.Diagnostics
+
+	resp.State.Raw, diags = restoreAliases(ctx, fwschemadata.DataDescriptionState, resp.State.Schema, readReq.Config.Raw, resp.State.Raw)
+
+	resp.Diagnostics.Append(diags...)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -100,6 +101,12 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 	resp.Diagnostics = readResp.Diagnostics
 	resp.NewState = &readResp.State
 
+	var diags diag.Diagnostics
+
+	resp.NewState.Raw, diags = restoreAliases(ctx, fwschemadata.DataDescriptionState, resp.NewState.Schema, readReq.State.Raw, resp.NewState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
 	if readResp.Private != nil {
 		if resp.Private == nil {
 			resp.Private = &privatestate.Data{}
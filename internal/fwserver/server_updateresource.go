@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -121,6 +122,12 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = &updateResp.State
 
+	var diags diag.Diagnostics
+
+	resp.NewState.Raw, diags = restoreAliases(ctx, fwschemadata.DataDescriptionState, resp.NewState.Schema, updateReq.Plan.Raw, resp.NewState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
 	if !resp.Diagnostics.HasError() && updateResp.State.Raw.Equal(nullSchemaData) {
 		resp.Diagnostics.AddError(
 			"Missing Resource State After Update",
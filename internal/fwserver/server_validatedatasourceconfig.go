@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -94,7 +95,9 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:                 *req.Config,
+		SuppressedWarningPaths: dataSourceSuppressedWarningPaths(ctx, req.DataSource),
+		DiagnosticSink:         s.DiagnosticSink(ctx),
 	}
 	validateSchemaResp := ValidateSchemaResponse{
 		Diagnostics: resp.Diagnostics,
@@ -102,5 +105,24 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 
 	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
-	resp.Diagnostics = validateSchemaResp.Diagnostics
+	resp.Diagnostics = s.AggregateWarnings(ctx, validateSchemaResp.Diagnostics)
+}
+
+// dataSourceSuppressedWarningPaths returns the path expressions of data
+// source configuration attributes and blocks which should not raise
+// framework-generated validation warnings, if the DataSource implements the
+// DataSourceWithSuppressedWarningPaths interface.
+func dataSourceSuppressedWarningPaths(ctx context.Context, d datasource.DataSource) path.Expressions {
+	dataSourceWithSuppressedWarningPaths, ok := d.(datasource.DataSourceWithSuppressedWarningPaths)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithSuppressedWarningPaths")
+	logging.FrameworkDebug(ctx, "Calling provider defined DataSource SuppressedWarningPaths")
+	suppressedWarningPaths := dataSourceWithSuppressedWarningPaths.SuppressedWarningPaths(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined DataSource SuppressedWarningPaths")
+
+	return suppressedWarningPaths
 }
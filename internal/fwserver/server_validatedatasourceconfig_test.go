@@ -86,6 +86,35 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+			"test_suppressed": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+		},
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":            tftypes.String,
+					"test_suppressed": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test":            tftypes.NewValue(tftypes.String, "test-value"),
+				"test_suppressed": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+		Schema: testSchemaDeprecated,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateDataSourceConfigRequest
@@ -213,6 +242,43 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-deprecated-DataSourceWithSuppressedWarningPaths": {
+			server: &fwserver.Server{
+				// Provider suppressed warning paths only apply to the
+				// provider configuration.
+				Provider: &testprovider.ProviderWithSuppressedWarningPaths{
+					SuppressedWarningPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test"),
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigDeprecated,
+				DataSource: &testprovider.DataSourceWithSuppressedWarningPaths{
+					DataSource: &testprovider.DataSource{
+						SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+							resp.Schema = testSchemaDeprecated
+						},
+					},
+					SuppressedWarningPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test_suppressed"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use other instead.",
+					),
+				},
+			},
+		},
 		"request-config-DataSourceWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -75,7 +75,9 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:                 *req.Config,
+		SuppressedWarningPaths: s.SuppressedWarningPaths(ctx),
+		DiagnosticSink:         s.DiagnosticSink(ctx),
 	}
 	validateSchemaResp := ValidateSchemaResponse{
 		Diagnostics: resp.Diagnostics,
@@ -83,7 +85,7 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 
 	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
-	resp.Diagnostics = validateSchemaResp.Diagnostics
+	resp.Diagnostics = s.AggregateWarnings(ctx, validateSchemaResp.Diagnostics)
 
 	// This RPC allows a modified configuration to be returned. This was
 	// previously used to allow a "required" provider attribute (as defined
@@ -86,6 +86,35 @@ func TestServerValidateProviderConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+			"test_suppressed": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+		},
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":            tftypes.String,
+					"test_suppressed": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test":            tftypes.NewValue(tftypes.String, "test-value"),
+				"test_suppressed": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+		Schema: testSchemaDeprecated,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateProviderConfigRequest
@@ -217,6 +246,35 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				PreparedConfig: &testConfig,
 			},
 		},
+		"request-config-deprecated-ProviderWithSuppressedWarningPaths": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithSuppressedWarningPaths{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchemaDeprecated
+						},
+					},
+					SuppressedWarningPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test_suppressed"),
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConfigDeprecated,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use other instead.",
+					),
+				},
+				PreparedConfig: &testConfigDeprecated,
+			},
+		},
 		"request-config-ProviderWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.ProviderWithValidateConfig{
@@ -295,3 +353,67 @@ func TestServerValidateProviderConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestServerValidateProviderConfigValidationDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+		},
+	}
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+		Schema: testSchema,
+	}
+
+	var received diag.Diagnostics
+
+	server := &fwserver.Server{
+		Provider: &testprovider.ProviderWithValidationDiagnostics{
+			Provider: &testprovider.Provider{
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Schema = testSchema
+				},
+			},
+			ValidationDiagnosticMethod: func(_ context.Context, d diag.Diagnostic) {
+				received = append(received, d)
+			},
+		},
+	}
+	request := &fwserver.ValidateProviderConfigRequest{
+		Config: &testConfig,
+	}
+	response := &fwserver.ValidateProviderConfigResponse{}
+
+	server.ValidateProviderConfig(context.Background(), request, response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeWarningDiagnostic(
+			path.Root("test"),
+			"Attribute Deprecated",
+			"Use other instead.",
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected response diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(received, expected); diff != "" {
+		t.Errorf("unexpected received diagnostics difference: %s", diff)
+	}
+}
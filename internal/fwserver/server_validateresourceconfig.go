@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -94,7 +95,9 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:                 *req.Config,
+		SuppressedWarningPaths: resourceSuppressedWarningPaths(ctx, req.Resource),
+		DiagnosticSink:         s.DiagnosticSink(ctx),
 	}
 	validateSchemaResp := ValidateSchemaResponse{
 		Diagnostics: resp.Diagnostics,
@@ -102,5 +105,24 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 
 	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
-	resp.Diagnostics = validateSchemaResp.Diagnostics
+	resp.Diagnostics = s.AggregateWarnings(ctx, validateSchemaResp.Diagnostics)
+}
+
+// resourceSuppressedWarningPaths returns the path expressions of resource
+// configuration attributes and blocks which should not raise
+// framework-generated validation warnings, if the Resource implements the
+// ResourceWithSuppressedWarningPaths interface.
+func resourceSuppressedWarningPaths(ctx context.Context, r resource.Resource) path.Expressions {
+	resourceWithSuppressedWarningPaths, ok := r.(resource.ResourceWithSuppressedWarningPaths)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithSuppressedWarningPaths")
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource SuppressedWarningPaths")
+	suppressedWarningPaths := resourceWithSuppressedWarningPaths.SuppressedWarningPaths(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Resource SuppressedWarningPaths")
+
+	return suppressedWarningPaths
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -86,6 +88,90 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+			"test_suppressed": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+		},
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":            tftypes.String,
+					"test_suppressed": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test":            tftypes.NewValue(tftypes.String, "test-value"),
+				"test_suppressed": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+		Schema: testSchemaDeprecated,
+	}
+
+	testSchemaDeprecatedAggregated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_a": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+			"test_b": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+			"test_c": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+		},
+	}
+
+	testConfigDeprecatedAggregated := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_a": tftypes.String,
+					"test_b": tftypes.String,
+					"test_c": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test_a": tftypes.NewValue(tftypes.String, "test-value"),
+				"test_b": tftypes.NewValue(tftypes.String, "test-value"),
+				"test_c": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+		Schema: testSchemaDeprecatedAggregated,
+	}
+
+	testSchemaValidateOnApply := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					schemavalidator.ValidateOnApply(stringvalidator.HasPrefix("https://")),
+				},
+			},
+		},
+	}
+
+	testConfigValidateOnApply := func(value interface{}) *tfsdk.Config {
+		return &tfsdk.Config{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, value),
+			}),
+			Schema: testSchemaValidateOnApply,
+		}
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -125,6 +211,72 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-deprecated-ResourceWithSuppressedWarningPaths": {
+			server: &fwserver.Server{
+				// Provider suppressed warning paths only apply to the
+				// provider configuration.
+				Provider: &testprovider.ProviderWithSuppressedWarningPaths{
+					SuppressedWarningPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test"),
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigDeprecated,
+				Resource: &testprovider.ResourceWithSuppressedWarningPaths{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchemaDeprecated
+						},
+					},
+					SuppressedWarningPathsMethod: func(_ context.Context) path.Expressions {
+						return path.Expressions{
+							path.MatchRoot("test_suppressed"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use other instead.",
+					),
+				},
+			},
+		},
+		"request-config-deprecated-AggregatedWarnings": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithAggregatedWarnings{
+					AggregatedWarningsMaxPathsMethod: func(_ context.Context) int {
+						return 2
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigDeprecatedAggregated,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecatedAggregated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Attribute Deprecated",
+						"Use other instead.\n\n"+
+							"This warning occurred at 3 paths:\n"+
+							"  - test_a\n"+
+							"  - test_b\n"+
+							"and 1 more.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator-diagnostic": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -147,6 +299,42 @@ func TestServerValidateResourceConfig(t *testing.T) {
 				},
 			},
 		},
+		"request-config-AttributeValidator-ValidateOnApply-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigValidateOnApply(tftypes.UnknownValue),
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaValidateOnApply
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-AttributeValidator-ValidateOnApply-known": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigValidateOnApply("http://example.com"),
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaValidateOnApply
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must start with "https://", got: "http://example.com"`,
+					),
+				},
+			},
+		},
 		"request-config-ResourceWithConfigValidators": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -289,3 +477,67 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestServerValidateResourceConfigValidationDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use other instead.",
+			},
+		},
+	}
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+		Schema: testSchema,
+	}
+
+	var received diag.Diagnostics
+
+	server := &fwserver.Server{
+		Provider: &testprovider.ProviderWithValidationDiagnostics{
+			ValidationDiagnosticMethod: func(_ context.Context, d diag.Diagnostic) {
+				received = append(received, d)
+			},
+		},
+	}
+	request := &fwserver.ValidateResourceConfigRequest{
+		Config: &testConfig,
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = testSchema
+			},
+		},
+	}
+	response := &fwserver.ValidateResourceConfigResponse{}
+
+	server.ValidateResourceConfig(context.Background(), request, response)
+
+	expected := diag.Diagnostics{
+		diag.NewAttributeWarningDiagnostic(
+			path.Root("test"),
+			"Attribute Deprecated",
+			"Use other instead.",
+		),
+	}
+
+	if diff := cmp.Diff(response.Diagnostics, expected); diff != "" {
+		t.Errorf("unexpected response diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(received, expected); diff != "" {
+		t.Errorf("unexpected received diagnostics difference: %s", diff)
+	}
+}
@@ -0,0 +1,115 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// validatorCache records the validator.Memoizable validators which returned
+// no diagnostics for a value, so they are not called again for an equal
+// value of the same attribute or block. A validatorCache must only be used
+// for a single validation request, since validators may change between
+// requests.
+type validatorCache struct {
+	succeeded map[validatorCacheKey]struct{}
+}
+
+// validatorCacheKey identifies a validator of a schema attribute or block
+// and the Terraform encoding of a value.
+type validatorCacheKey struct {
+	// schemaPath is the attribute or block path with all element steps
+	// replaced, so it is equal for all values of the same schema attribute
+	// or block, such as the attribute of each nested object in a set.
+	schemaPath string
+
+	// validatorIndex is the position of the validator in the attribute or
+	// block validators.
+	validatorIndex int
+
+	// validatorType is the Go type of the validator.
+	validatorType string
+
+	// value is the MessagePack encoding of the value, which unlike JSON can
+	// represent unknown values.
+	value string
+}
+
+// newValidatorCache returns an empty validatorCache.
+func newValidatorCache() *validatorCache {
+	return &validatorCache{
+		succeeded: make(map[validatorCacheKey]struct{}),
+	}
+}
+
+// validatorCacheKey returns the cache key for calling the validator with the
+// value, and true if the validator implements validator.Memoizable and the
+// request has a cache.
+func (r ValidateAttributeRequest) validatorCacheKey(ctx context.Context, v validator.Describer, validatorIndex int, value attr.Value) (validatorCacheKey, bool) {
+	if r.validatorCache == nil {
+		return validatorCacheKey{}, false
+	}
+
+	memoizableValidator, ok := v.(validator.Memoizable)
+
+	if !ok || !memoizableValidator.ValidateMemoizable() {
+		return validatorCacheKey{}, false
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	// Values which cannot be encoded are not cached, which is always safe.
+	if err != nil {
+		return validatorCacheKey{}, false
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(tfValue.Type(), tfValue)
+
+	if err != nil {
+		return validatorCacheKey{}, false
+	}
+
+	return validatorCacheKey{
+		schemaPath:     validatorCacheSchemaPath(r.AttributePath),
+		validatorIndex: validatorIndex,
+		validatorType:  fmt.Sprintf("%T", v),
+		value:          string(dynamicValue.MsgPack),
+	}, true
+}
+
+// validatorSucceeded returns true if the validator previously returned no
+// diagnostics for the cache key.
+func (r ValidateAttributeRequest) validatorSucceeded(key validatorCacheKey) bool {
+	_, ok := r.validatorCache.succeeded[key]
+
+	return ok
+}
+
+// recordValidatorSucceeded records that the validator returned no
+// diagnostics for the cache key.
+func (r ValidateAttributeRequest) recordValidatorSucceeded(key validatorCacheKey) {
+	r.validatorCache.succeeded[key] = struct{}{}
+}
+
+// validatorCacheSchemaPath returns the string representation of the path with
+// all element steps replaced by a wildcard.
+func validatorCacheSchemaPath(p path.Path) string {
+	var b strings.Builder
+
+	for _, step := range p.Steps() {
+		switch s := step.(type) {
+		case path.PathStepAttributeName:
+			b.WriteString(".")
+			b.WriteString(string(s))
+		default:
+			b.WriteString("[*]")
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,211 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testMemoizableConfig returns a configuration with a list nested attribute
+// of objects with the given name attribute values, and a validator of the
+// name attribute.
+func testMemoizableConfig(v validator.String, names ...interface{}) tfsdk.Config {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	elements := make([]tftypes.Value, 0, len(names))
+
+	for _, name := range names {
+		elements = append(elements, tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		}))
+	}
+
+	return tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: objectType},
+				},
+			},
+			map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: objectType}, elements),
+			},
+		),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testschema.NestedAttribute{
+					NestedObject: testschema.NestedAttributeObject{
+						Attributes: map[string]fwschema.Attribute{
+							"name": testschema.AttributeWithStringValidators{
+								Optional:   true,
+								Validators: []validator.String{v},
+							},
+						},
+					},
+					NestingMode: fwschema.NestingModeList,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// testMemoizableValidator returns a validator which raises an error for
+// "invalid" values and counts each call.
+func testMemoizableValidator(calls *int64) testvalidator.String {
+	return testvalidator.String{
+		ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			atomic.AddInt64(calls, 1)
+
+			if req.ConfigValue.ValueString() == "invalid" {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid Value", "Value must not be invalid.")
+			}
+		},
+	}
+}
+
+func TestSchemaValidateMemoizable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		memoize       bool
+		names         []interface{}
+		requests      int
+		expectedCalls int64
+		expectedDiags diag.Diagnostics
+	}{
+		"identical-valid-values": {
+			memoize:       true,
+			names:         []interface{}{"valid", "valid", "valid"},
+			requests:      1,
+			expectedCalls: 1,
+		},
+		"identical-valid-values-not-memoizable": {
+			memoize:       false,
+			names:         []interface{}{"valid", "valid", "valid"},
+			requests:      1,
+			expectedCalls: 3,
+		},
+		"different-valid-values": {
+			memoize:       true,
+			names:         []interface{}{"valid1", "valid2", "valid1"},
+			requests:      1,
+			expectedCalls: 2,
+		},
+		"identical-null-and-unknown-values": {
+			memoize:       true,
+			names:         []interface{}{nil, tftypes.UnknownValue, "", nil, tftypes.UnknownValue, ""},
+			requests:      1,
+			expectedCalls: 3,
+		},
+		"identical-invalid-values": {
+			memoize:       true,
+			names:         []interface{}{"invalid", "valid", "invalid", "invalid"},
+			requests:      1,
+			expectedCalls: 4,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(0).AtName("name"), "Invalid Value", "Value must not be invalid."),
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(2).AtName("name"), "Invalid Value", "Value must not be invalid."),
+				diag.NewAttributeErrorDiagnostic(path.Root("list").AtListIndex(3).AtName("name"), "Invalid Value", "Value must not be invalid."),
+			},
+		},
+		"separate-requests": {
+			memoize:       true,
+			names:         []interface{}{"valid", "valid"},
+			requests:      2,
+			expectedCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int64
+
+			var v validator.String = testMemoizableValidator(&calls)
+
+			if testCase.memoize {
+				v = schemavalidator.Memoize(v)
+			}
+
+			config := testMemoizableConfig(v, testCase.names...)
+
+			var got diag.Diagnostics
+
+			for i := 0; i < testCase.requests; i++ {
+				resp := &ValidateSchemaResponse{}
+
+				SchemaValidate(context.Background(), config.Schema, ValidateSchemaRequest{Config: config}, resp)
+
+				got = resp.Diagnostics
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d validator calls, got %d", testCase.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func BenchmarkSchemaValidateMemoizable(b *testing.B) {
+	// Simulate an expensive validator by compiling a regular expression for
+	// each call.
+	expensive := testvalidator.String{
+		ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if !regexp.MustCompile(`^[a-z]+(-[a-z0-9]+)*$`).MatchString(req.ConfigValue.ValueString()) {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid Value", "Value must be a valid name.")
+			}
+		},
+	}
+
+	names := make([]interface{}, 1000)
+
+	for i := range names {
+		names[i] = "identical-name"
+	}
+
+	benchmarks := map[string]validator.String{
+		"memoized":     schemavalidator.Memoize(expensive),
+		"not-memoized": expensive,
+	}
+
+	for name, v := range benchmarks {
+		config := testMemoizableConfig(v, names...)
+
+		b.Run(fmt.Sprintf("%s-%d", name, len(names)), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				resp := &ValidateSchemaResponse{}
+
+				SchemaValidate(context.Background(), config.Schema, ValidateSchemaRequest{Config: config}, resp)
+
+				if resp.Diagnostics.HasError() {
+					b.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
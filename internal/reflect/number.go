@@ -38,6 +38,13 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 		}))
 		return target, diags
 	}
+
+	if opts.CoerceNumbers {
+		if coerced, coerceDiags, ok := coerceNumber(result, target, path); ok {
+			return coerced, append(diags, coerceDiags...)
+		}
+	}
+
 	roundingError := fmt.Errorf("cannot store %s in %s", result.String(), target.Type())
 	roundingErrorDiag := diag.NewAttributeErrorDiagnostic(
 		path,
@@ -235,6 +242,88 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 	return target, diags
 }
 
+// coerceNumber converts the number into the target number type, returning a
+// warning diagnostic if the number cannot be losslessly represented. Returns
+// false if the target is not a supported number type.
+func coerceNumber(value *big.Float, target reflect.Value, path path.Path) (reflect.Value, diag.Diagnostics, bool) {
+	var diags diag.Diagnostics
+	var exact bool
+
+	result := reflect.New(target.Type()).Elem()
+
+	switch {
+	case target.Type() == reflect.TypeOf(big.NewInt(0)):
+		intResult, acc := value.Int(nil)
+		exact = acc == big.Exact
+		result = reflect.ValueOf(intResult)
+	case target.Kind() >= reflect.Int && target.Kind() <= reflect.Int64:
+		bits := target.Type().Bits()
+		minValue := int64(-1) << (bits - 1)
+		maxValue := int64(1)<<(bits-1) - 1
+
+		intResult, acc := value.Int64()
+		exact = acc == big.Exact
+
+		if intResult < minValue {
+			intResult = minValue
+			exact = false
+		}
+
+		if intResult > maxValue {
+			intResult = maxValue
+			exact = false
+		}
+
+		result.SetInt(intResult)
+	case target.Kind() >= reflect.Uint && target.Kind() <= reflect.Uint64:
+		bits := target.Type().Bits()
+		maxValue := uint64(math.MaxUint64) >> (64 - bits)
+
+		uintResult, acc := value.Uint64()
+		exact = acc == big.Exact
+
+		if uintResult > maxValue {
+			uintResult = maxValue
+			exact = false
+		}
+
+		result.SetUint(uintResult)
+	case target.Kind() == reflect.Float32:
+		floatResult, _ := value.Float32()
+		exact = true
+
+		if math.IsInf(float64(floatResult), 0) {
+			floatResult = float32(math.Copysign(math.MaxFloat32, float64(floatResult)))
+			exact = false
+		}
+
+		result.SetFloat(float64(floatResult))
+	case target.Kind() == reflect.Float64:
+		floatResult, _ := value.Float64()
+		exact = true
+
+		if math.IsInf(floatResult, 0) {
+			floatResult = math.Copysign(math.MaxFloat64, floatResult)
+			exact = false
+		}
+
+		result.SetFloat(floatResult)
+	default:
+		return target, nil, false
+	}
+
+	if !exact {
+		diags.AddAttributeWarning(
+			path,
+			"Number Precision Loss",
+			fmt.Sprintf("The number %s cannot be exactly represented as %s and was converted to %v. ", value.Text('g', -1), target.Type(), result.Interface())+
+				"Verify the configured value or contact the provider developers if this value is expected to be supported.",
+		)
+	}
+
+	return result, diags, true
+}
+
 // FromInt creates an attr.Value using `typ` from an int64.
 //
 // It is meant to be called through FromValue, not directly.
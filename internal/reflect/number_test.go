@@ -451,6 +451,51 @@ func TestNumber_int64(t *testing.T) {
 	}
 }
 
+func TestNumber_int64Coerced(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, -1.5), reflect.ValueOf(n), refl.Options{
+		CoerceNumbers: true,
+	}, path.Empty())
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeWarningDiagnostic(
+			path.Empty(),
+			"Number Precision Loss",
+			"The number -1.5 cannot be exactly represented as int64 and was converted to -1. "+
+				"Verify the configured value or contact the provider developers if this value is expected to be supported.",
+		),
+	}
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != -1 {
+		t.Errorf("Expected %v, got %v", -1, n)
+	}
+}
+
+func TestNumber_int8CoercedOverflow(t *testing.T) {
+	t.Parallel()
+
+	var n int8
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, overflowInt), reflect.ValueOf(n), refl.Options{
+		CoerceNumbers: true,
+	}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != math.MaxInt8 {
+		t.Errorf("Expected %v, got %v", math.MaxInt8, n)
+	}
+}
+
 func TestNumber_int64Overflow(t *testing.T) {
 	t.Parallel()
 
@@ -17,4 +17,18 @@ type Options struct {
 	// perfectly in the types they're being stored in, rather than
 	// returning errors. Numbers will always be rounded towards 0.
 	AllowRoundingNumbers bool
+
+	// AllowMissingFields controls whether object attributes without a
+	// corresponding struct field are ignored, rather than returning an
+	// error, when building a struct. Struct fields without a corresponding
+	// object attribute always return an error.
+	AllowMissingFields bool
+
+	// CoerceNumbers converts numbers which cannot be losslessly stored in
+	// the target number type, returning warning diagnostics on precision
+	// loss rather than errors. Integer targets are truncated towards 0 and
+	// limited to the range of the type. Float targets use the nearest
+	// representable value, with a warning diagnostic only if the number is
+	// outside the range of the type.
+	CoerceNumbers bool
 }
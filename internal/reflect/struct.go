@@ -23,7 +23,9 @@ import (
 // attributes in the type of `object` must have a corresponding property.
 // Properties that don't map to object attributes must have a `tfsdk:"-"` tag,
 // explicitly defining them as not part of the object. This is to catch typos
-// and other mistakes early.
+// and other mistakes early. If the AllowMissingFields option is enabled,
+// attributes in the type of `object` without a corresponding property are
+// ignored.
 //
 // Struct is meant to be called from Into, not directly.
 func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
@@ -90,9 +92,11 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			objectMissing = append(objectMissing, field)
 		}
 	}
-	for field := range objectFields {
-		if _, ok := targetFields[field]; !ok {
-			targetMissing = append(targetMissing, field)
+	if !opts.AllowMissingFields {
+		for field := range objectFields {
+			if _, ok := targetFields[field]; !ok {
+				targetMissing = append(targetMissing, field)
+			}
 		}
 	}
 	if len(objectMissing) > 0 || len(targetMissing) > 0 {
@@ -113,8 +117,8 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 
 	attrTypes := attrsType.AttributeTypes()
 
-	// now that we know they match perfectly, fill the struct with the
-	// values in the object
+	// now that we know they match, fill the struct with the values in
+	// the object
 	result := reflect.New(target.Type()).Elem()
 	for field, structFieldPos := range targetFields {
 		attrType, ok := attrTypes[field]
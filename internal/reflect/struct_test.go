@@ -124,6 +124,35 @@ func TestNewStruct_structMissingProperties(t *testing.T) {
 	}
 }
 
+func TestNewStruct_structMissingPropertiesAllowMissingFields(t *testing.T) {
+	t.Parallel()
+
+	var s struct {
+		A string `tfsdk:"a"`
+	}
+	result, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a": types.StringType,
+			"b": types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+			"b": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "hello"),
+		"b": tftypes.NewValue(tftypes.String, "world"),
+	}), reflect.ValueOf(s), refl.Options{AllowMissingFields: true}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+	if s.A != "hello" {
+		t.Errorf("Expected s.A to be %q, was %q", "hello", s.A)
+	}
+}
+
 func TestNewStruct_objectMissingFieldsAndStructMissingProperties(t *testing.T) {
 	t.Parallel()
 
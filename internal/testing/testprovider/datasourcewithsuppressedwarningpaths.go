@@ -0,0 +1,29 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ datasource.DataSource = &DataSourceWithSuppressedWarningPaths{}
+var _ datasource.DataSourceWithSuppressedWarningPaths = &DataSourceWithSuppressedWarningPaths{}
+
+// Declarative datasource.DataSourceWithSuppressedWarningPaths for unit testing.
+type DataSourceWithSuppressedWarningPaths struct {
+	*DataSource
+
+	// DataSourceWithSuppressedWarningPaths interface methods
+	SuppressedWarningPathsMethod func(context.Context) path.Expressions
+}
+
+// SuppressedWarningPaths satisfies the
+// datasource.DataSourceWithSuppressedWarningPaths interface.
+func (p *DataSourceWithSuppressedWarningPaths) SuppressedWarningPaths(ctx context.Context) path.Expressions {
+	if p.SuppressedWarningPathsMethod == nil {
+		return nil
+	}
+
+	return p.SuppressedWarningPathsMethod(ctx)
+}
@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithAggregatedWarnings{}
+var _ provider.ProviderWithAggregatedWarnings = &ProviderWithAggregatedWarnings{}
+
+// Declarative provider.ProviderWithAggregatedWarnings for unit testing.
+type ProviderWithAggregatedWarnings struct {
+	*Provider
+
+	// ProviderWithAggregatedWarnings interface methods
+	AggregatedWarningsMaxPathsMethod func(context.Context) int
+}
+
+// AggregatedWarningsMaxPaths satisfies the
+// provider.ProviderWithAggregatedWarnings interface.
+func (p *ProviderWithAggregatedWarnings) AggregatedWarningsMaxPaths(ctx context.Context) int {
+	if p.AggregatedWarningsMaxPathsMethod == nil {
+		return 0
+	}
+
+	return p.AggregatedWarningsMaxPathsMethod(ctx)
+}
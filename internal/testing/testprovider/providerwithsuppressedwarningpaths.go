@@ -0,0 +1,29 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithSuppressedWarningPaths{}
+var _ provider.ProviderWithSuppressedWarningPaths = &ProviderWithSuppressedWarningPaths{}
+
+// Declarative provider.ProviderWithSuppressedWarningPaths for unit testing.
+type ProviderWithSuppressedWarningPaths struct {
+	*Provider
+
+	// ProviderWithSuppressedWarningPaths interface methods
+	SuppressedWarningPathsMethod func(context.Context) path.Expressions
+}
+
+// SuppressedWarningPaths satisfies the
+// provider.ProviderWithSuppressedWarningPaths interface.
+func (p *ProviderWithSuppressedWarningPaths) SuppressedWarningPaths(ctx context.Context) path.Expressions {
+	if p.SuppressedWarningPathsMethod == nil {
+		return nil
+	}
+
+	return p.SuppressedWarningPathsMethod(ctx)
+}
@@ -0,0 +1,29 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithValidationDiagnostics{}
+var _ provider.ProviderWithValidationDiagnostics = &ProviderWithValidationDiagnostics{}
+
+// Declarative provider.ProviderWithValidationDiagnostics for unit testing.
+type ProviderWithValidationDiagnostics struct {
+	*Provider
+
+	// ProviderWithValidationDiagnostics interface methods
+	ValidationDiagnosticMethod func(context.Context, diag.Diagnostic)
+}
+
+// ValidationDiagnostic satisfies the
+// provider.ProviderWithValidationDiagnostics interface.
+func (p *ProviderWithValidationDiagnostics) ValidationDiagnostic(ctx context.Context, d diag.Diagnostic) {
+	if p.ValidationDiagnosticMethod == nil {
+		return
+	}
+
+	p.ValidationDiagnosticMethod(ctx, d)
+}
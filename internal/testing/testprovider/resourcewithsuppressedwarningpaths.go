@@ -0,0 +1,29 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithSuppressedWarningPaths{}
+var _ resource.ResourceWithSuppressedWarningPaths = &ResourceWithSuppressedWarningPaths{}
+
+// Declarative resource.ResourceWithSuppressedWarningPaths for unit testing.
+type ResourceWithSuppressedWarningPaths struct {
+	*Resource
+
+	// ResourceWithSuppressedWarningPaths interface methods
+	SuppressedWarningPathsMethod func(context.Context) path.Expressions
+}
+
+// SuppressedWarningPaths satisfies the
+// resource.ResourceWithSuppressedWarningPaths interface.
+func (p *ResourceWithSuppressedWarningPaths) SuppressedWarningPaths(ctx context.Context) path.Expressions {
+	if p.SuppressedWarningPathsMethod == nil {
+		return nil
+	}
+
+	return p.SuppressedWarningPathsMethod(ctx)
+}
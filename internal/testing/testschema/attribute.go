@@ -6,9 +6,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwschema.Attribute = Attribute{}
+var (
+	_ fwschema.Attribute            = Attribute{}
+	_ fwschema.AttributeWithAliases = Attribute{}
+)
 
 type Attribute struct {
+	Aliases             []string
 	Computed            bool
 	DeprecationMessage  string
 	Description         string
@@ -35,6 +39,11 @@ func (a Attribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetAliases satisfies the fwschema.AttributeWithAliases interface.
+func (a Attribute) GetAliases() []string {
+	return a.Aliases
+}
+
 // GetDeprecationMessage satisfies the fwschema.Attribute interface.
 func (a Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
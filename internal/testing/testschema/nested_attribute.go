@@ -1,6 +1,8 @@
 package testschema
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,7 +26,16 @@ type NestedAttribute struct {
 
 // ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
 func (a NestedAttribute) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
-	return a.GetType().ApplyTerraform5AttributePathStep(step)
+	if a.GetNestingMode() == fwschema.NestingModeSingle {
+		return a.GetNestedObject().ApplyTerraform5AttributePathStep(step)
+	}
+
+	switch step.(type) {
+	case tftypes.ElementKeyInt, tftypes.ElementKeyString, tftypes.ElementKeyValue:
+		return a.GetNestedObject(), nil
+	default:
+		return nil, fmt.Errorf("cannot apply AttributePathStep %T to NestedAttribute", step)
+	}
 }
 
 // Equal satisfies the fwschema.Attribute interface.
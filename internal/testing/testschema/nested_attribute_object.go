@@ -3,7 +3,6 @@ package testschema
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -26,7 +25,7 @@ func (o NestedAttributeObject) ApplyTerraform5AttributePathStep(step tftypes.Att
 		return nil, fmt.Errorf("cannot apply AttributePathStep %T to NestedAttributeObject", step)
 	}
 
-	attribute, ok := o.GetAttributes()[string(name)]
+	attribute, ok := fwschema.AttributeNamed(o.GetAttributes(), string(name))
 
 	if ok {
 		return attribute, nil
@@ -68,13 +67,7 @@ func (o NestedAttributeObject) GetAttributes() fwschema.UnderlyingAttributes {
 
 // Type returns the framework type of the NestedAttributeObject.
 func (o NestedAttributeObject) Type() basetypes.ObjectTypable {
-	attrTypes := make(map[string]attr.Type, len(o.Attributes))
-
-	for name, attribute := range o.Attributes {
-		attrTypes[name] = attribute.GetType()
-	}
-
 	return types.ObjectType{
-		AttrTypes: attrTypes,
+		AttrTypes: fwschema.AttributeTypesWithAliases(o.Attributes),
 	}
 }
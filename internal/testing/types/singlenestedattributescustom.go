@@ -20,6 +20,16 @@ type SingleNestedAttributesCustomTypeType struct {
 	types.ObjectType
 }
 
+func (tt SingleNestedAttributesCustomTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(SingleNestedAttributesCustomTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return tt.ObjectType.Equal(other.ObjectType)
+}
+
 func (tt SingleNestedAttributesCustomTypeType) ValueFromTerraform(ctx context.Context, value tftypes.Value) (attr.Value, error) {
 	val, err := tt.ObjectType.ValueFromTerraform(ctx, value)
 	if err != nil {
@@ -39,3 +49,13 @@ func (tt SingleNestedAttributesCustomTypeType) ValueFromTerraform(ctx context.Co
 type SingleNestedAttributesCustomValue struct {
 	types.Object
 }
+
+func (v SingleNestedAttributesCustomValue) Equal(o attr.Value) bool {
+	other, ok := o.(SingleNestedAttributesCustomValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.Object.Equal(other.Object)
+}
@@ -416,6 +416,43 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 			},
 		},
+		"data-source-block-attribute-type-list-nested-attributes": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Blocks: map[string]datasourceschema.Block{
+							"test_block": datasourceschema.SingleNestedBlock{
+								Attributes: map[string]datasourceschema.Attribute{
+									"test_attribute": datasourceschema.ListNestedAttribute{
+										NestedObject: datasourceschema.NestedAttributeObject{
+											Attributes: map[string]datasourceschema.Attribute{
+												"test_nested_attribute": datasourceschema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{
+					"test_data_source": nil,
+				},
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Error converting data source schema",
+						Detail:   "The schema for the data source \"test_data_source\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\nAttributeName(\"test_block\").AttributeName(\"test_attribute\"): protocol version 5 cannot have Attributes set",
+					},
+				},
+				ResourceSchemas: map[string]*tfprotov5.Schema{},
+			},
+		},
 		"data-source-attribute-type-list-object": {
 			input: &fwserver.GetProviderSchemaResponse{
 				DataSourceSchemas: map[string]fwschema.Schema{
@@ -22,7 +22,7 @@ func Schema(ctx context.Context, s fwschema.Schema) (*tfprotov5.Schema, error) {
 	var attrs []*tfprotov5.SchemaAttribute
 	var blocks []*tfprotov5.SchemaNestedBlock
 
-	for name, attr := range s.GetAttributes() {
+	for name, attr := range fwschema.AttributesWithAliases(s.GetAttributes()) {
 		a, err := SchemaAttribute(ctx, name, tftypes.NewAttributePath().WithAttributeName(name), attr)
 
 		if err != nil {
@@ -44,7 +44,7 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 
 	nestedBlockObject := b.GetNestedObject()
 
-	for attrName, attr := range nestedBlockObject.GetAttributes() {
+	for attrName, attr := range fwschema.AttributesWithAliases(nestedBlockObject.GetAttributes()) {
 		attrPath := path.WithAttributeName(attrName)
 		attrProto6, err := SchemaAttribute(ctx, attrName, attrPath, attr)
 
@@ -61,7 +61,7 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
 	}
 
-	for nestedName, nestedA := range nestedAttribute.GetNestedObject().GetAttributes() {
+	for nestedName, nestedA := range fwschema.AttributesWithAliases(nestedAttribute.GetNestedObject().GetAttributes()) {
 		nestedSchemaAttribute, err := SchemaAttribute(ctx, nestedName, path.WithAttributeName(nestedName), nestedA)
 
 		if err != nil {
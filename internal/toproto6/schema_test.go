@@ -76,6 +76,39 @@ func TestSchema(t *testing.T) {
 				},
 			},
 		},
+		"attribute-aliases": {
+			input: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"string": testschema.Attribute{
+						Aliases:   []string{"old_string"},
+						Computed:  true,
+						Optional:  true,
+						Sensitive: true,
+						Type:      types.StringType,
+					},
+				},
+			},
+			expected: &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:       "old_string",
+							Type:       tftypes.String,
+							Deprecated: true,
+							Optional:   true,
+							Sensitive:  true,
+						},
+						{
+							Name:      "string",
+							Type:      tftypes.String,
+							Computed:  true,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
 		"complex-attrs": {
 			input: testschema.Schema{
 				Version: 2,
@@ -275,6 +275,22 @@ func TestPathExpression(t *testing.T) {
 			path:     path.Root("test").AtListIndex(1),
 			expected: path.MatchRoot("test").AtListIndex(1),
 		},
+		"AttributeName": {
+			path:     path.Root("test").AtName("nested"),
+			expected: path.MatchRoot("test").AtName("nested"),
+		},
+		"ElementKeyString": {
+			path:     path.Root("test").AtMapKey("test-key"),
+			expected: path.MatchRoot("test").AtMapKey("test-key"),
+		},
+		"ElementKeyValue": {
+			path:     path.Root("test").AtSetValue(types.StringValue("test-value")),
+			expected: path.MatchRoot("test").AtSetValue(types.StringValue("test-value")),
+		},
+		"mixed": {
+			path:     path.Root("test").AtListIndex(0).AtMapKey("test-key").AtSetValue(types.Int64Value(1)).AtName("nested"),
+			expected: path.MatchRoot("test").AtListIndex(0).AtMapKey("test-key").AtSetValue(types.Int64Value(1)).AtName("nested"),
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -292,6 +308,79 @@ func TestPathExpression(t *testing.T) {
 	}
 }
 
+func TestPathExpressionMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path       path.Path
+		nonMatches path.Paths
+	}{
+		"AttributeName": {
+			path: path.Root("test").AtName("nested"),
+			nonMatches: path.Paths{
+				path.Root("test"),
+				path.Root("test").AtName("other"),
+				path.Root("other").AtName("nested"),
+				path.Root("test").AtName("nested").AtName("child"),
+			},
+		},
+		"ElementKeyInt": {
+			path: path.Root("test").AtListIndex(1),
+			nonMatches: path.Paths{
+				path.Root("test"),
+				path.Root("test").AtListIndex(0),
+				path.Root("test").AtListIndex(1).AtName("child"),
+			},
+		},
+		"ElementKeyString": {
+			path: path.Root("test").AtMapKey("test-key"),
+			nonMatches: path.Paths{
+				path.Root("test"),
+				path.Root("test").AtMapKey("other-key"),
+				path.Root("test").AtMapKey("test-key").AtName("child"),
+			},
+		},
+		"ElementKeyValue": {
+			path: path.Root("test").AtSetValue(types.StringValue("test-value")),
+			nonMatches: path.Paths{
+				path.Root("test"),
+				path.Root("test").AtSetValue(types.StringValue("other-value")),
+				path.Root("test").AtSetValue(types.StringValue("test-value")).AtName("child"),
+			},
+		},
+		"mixed": {
+			path: path.Root("test").AtListIndex(0).AtMapKey("test-key").AtSetValue(types.Int64Value(1)).AtName("nested"),
+			nonMatches: path.Paths{
+				path.Root("test").AtListIndex(1).AtMapKey("test-key").AtSetValue(types.Int64Value(1)).AtName("nested"),
+				path.Root("test").AtListIndex(0).AtMapKey("other-key").AtSetValue(types.Int64Value(1)).AtName("nested"),
+				path.Root("test").AtListIndex(0).AtMapKey("test-key").AtSetValue(types.Int64Value(2)).AtName("nested"),
+				path.Root("test").AtListIndex(0).AtMapKey("test-key").AtSetValue(types.Int64Value(1)).AtName("other"),
+				path.Root("test").AtListIndex(0).AtMapKey("test-key").AtSetValue(types.Int64Value(1)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expression := testCase.path.Expression()
+
+			if !expression.Matches(testCase.path) {
+				t.Errorf("expected expression %s to match path %s", expression, testCase.path)
+			}
+
+			for _, nonMatch := range testCase.nonMatches {
+				if expression.Matches(nonMatch) {
+					t.Errorf("expected expression %s to not match path %s", expression, nonMatch)
+				}
+			}
+		})
+	}
+}
+
 func TestPathParentPath(t *testing.T) {
 	t.Parallel()
 
@@ -99,7 +99,8 @@ func (s Schema) TypeAtTerraformPath(ctx context.Context, p *tftypes.AttributePat
 	return fwschema.SchemaTypeAtTerraformPath(ctx, s, p)
 }
 
-// Validate verifies that the schema is not using a reserved field name for a top-level attribute.
+// Validate verifies that the schema is not using a reserved field name for a top-level attribute
+// and that no attribute is defined with an invalid combination of Computed, Optional, and Required.
 func (s Schema) Validate() diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -111,6 +112,8 @@ func (s Schema) Validate() diag.Diagnostics {
 		diags.Append(d...)
 	}
 
+	diags.Append(fwschema.SchemaValidateImplementation(s)...)
+
 	return diags
 }
 
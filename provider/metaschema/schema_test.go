@@ -918,6 +918,42 @@ func TestSchemaValidate(t *testing.T) {
 				),
 			},
 		},
+		"attribute-required": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test_attr": metaschema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"attribute-optional": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test_attr": metaschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"attribute-required-optional": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test_attr": metaschema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute cannot be both Required and Optional. Use only one of these fields to define whether a configuration value must be set. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
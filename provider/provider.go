@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -14,6 +16,8 @@ import (
 //   - Validation: Schema-based or entire configuration
 //     via ProviderWithConfigValidators or ProviderWithValidateConfig.
 //   - Meta Schema: ProviderWithMetaSchema
+//   - Warning Suppression: ProviderWithSuppressedWarningPaths
+//   - Warning Aggregation: ProviderWithAggregatedWarnings
 type Provider interface {
 	// Metadata should return the metadata for the provider, such as
 	// a type name and version data.
@@ -86,6 +90,66 @@ type ProviderWithMetaSchema interface {
 	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
 }
 
+// ProviderWithSuppressedWarningPaths is an interface type that extends
+// Provider to suppress framework-generated validation warnings, such as
+// attribute and block deprecation warnings, for specific attributes and
+// blocks. The intended use case is enabling practitioners with large
+// configurations that intentionally use deprecated attributes during a
+// migration to opt out of the warnings, such as via a provider-specific
+// environment variable.
+//
+// The path expressions are only matched against the attributes and blocks of
+// the provider configuration during validation. Implement the
+// datasource.DataSourceWithSuppressedWarningPaths or
+// resource.ResourceWithSuppressedWarningPaths interfaces to suppress warnings
+// for a data source or resource configuration. Error diagnostics and any
+// diagnostics returned by provider-defined validators are never suppressed.
+type ProviderWithSuppressedWarningPaths interface {
+	Provider
+
+	// SuppressedWarningPaths should return the path expressions of
+	// provider configuration attributes and blocks which should not raise
+	// framework-generated validation warnings.
+	SuppressedWarningPaths(context.Context) path.Expressions
+}
+
+// ProviderWithAggregatedWarnings is an interface type that extends Provider
+// to collapse repeated validation warnings, such as the same deprecation
+// warning raised for many attributes, into a single warning which lists the
+// affected paths. The intended use case is providers with large schemas or
+// configurations where practitioners would otherwise receive a long list of
+// identical warnings.
+//
+// Aggregation is performed after data source, provider, and resource
+// configuration validation. Only warnings with an attribute path and an
+// identical summary and detail are aggregated. Error diagnostics are never
+// aggregated.
+type ProviderWithAggregatedWarnings interface {
+	Provider
+
+	// AggregatedWarningsMaxPaths should return the maximum number of
+	// affected paths to list in an aggregated warning. Any remaining paths
+	// are summarized by their count. A value less than 1 lists no paths.
+	AggregatedWarningsMaxPaths(context.Context) int
+}
+
+// ProviderWithValidationDiagnostics is an interface type that extends
+// Provider to receive each diagnostic of data source, provider, and resource
+// configuration schema validation as it is produced, rather than once
+// validation of the whole configuration is complete. The intended use case is
+// streaming the diagnostics of large configurations, such as to logs or
+// progress reporting.
+//
+// Diagnostics are still returned to Terraform. Each unique diagnostic is
+// received once, before any ProviderWithAggregatedWarnings aggregation.
+type ProviderWithValidationDiagnostics interface {
+	Provider
+
+	// ValidationDiagnostic is called once with each diagnostic of schema
+	// validation, in the same order the diagnostics are returned.
+	ValidationDiagnostic(context.Context, diag.Diagnostic)
+}
+
 // ProviderWithValidateConfig is an interface type that extends Provider to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
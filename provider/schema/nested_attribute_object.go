@@ -1,6 +1,10 @@
 package schema
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -44,6 +48,31 @@ type NestedAttributeObject struct {
 	Validators []validator.Object
 }
 
+// NestedAttributeObjectFromObjectType returns a NestedAttributeObject with
+// the given Attributes and an object type of typ, returning error diagnostics
+// if the attribute names and types of typ are not consistent with the given
+// attributes. The CustomType field is set if typ is not basetypes.ObjectType.
+//
+// This function is intended to reduce duplication when programmatically
+// generating nested attribute objects from an existing object type.
+func NestedAttributeObjectFromObjectType(typ basetypes.ObjectTypable, attributes map[string]Attribute) (NestedAttributeObject, diag.Diagnostics) {
+	diags := fwschema.NestedAttributeObjectTypeValidate(typ, schemaAttributes(attributes))
+
+	if diags.HasError() {
+		return NestedAttributeObject{}, diags
+	}
+
+	o := NestedAttributeObject{
+		Attributes: attributes,
+	}
+
+	if _, ok := typ.(basetypes.ObjectType); !ok {
+		o.CustomType = typ
+	}
+
+	return o, diags
+}
+
 // ApplyTerraform5AttributePathStep performs an AttributeName step on the
 // underlying attributes or returns an error.
 func (o NestedAttributeObject) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
@@ -64,6 +93,12 @@ func (o NestedAttributeObject) GetAttributes() fwschema.UnderlyingAttributes {
 	return schemaAttributes(o.Attributes)
 }
 
+// NullValue returns the null value of the NestedAttributeObject type, which
+// is the basetypes.ObjectValuable of the CustomType field if set.
+func (o NestedAttributeObject) NullValue(ctx context.Context) (attr.Value, diag.Diagnostics) {
+	return fwschema.NestedAttributeObjectNullValue(ctx, o)
+}
+
 // ObjectValidators returns the Validators field value.
 func (o NestedAttributeObject) ObjectValidators() []validator.Object {
 	return o.Validators
@@ -77,3 +112,9 @@ func (o NestedAttributeObject) Type() basetypes.ObjectTypable {
 
 	return fwschema.NestedAttributeObjectType(o)
 }
+
+// UnknownValue returns the unknown value of the NestedAttributeObject type,
+// which is the basetypes.ObjectValuable of the CustomType field if set.
+func (o NestedAttributeObject) UnknownValue(ctx context.Context) (attr.Value, diag.Diagnostics) {
+	return fwschema.NestedAttributeObjectUnknownValue(ctx, o)
+}
@@ -1,16 +1,20 @@
 package schema_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -155,6 +159,135 @@ func TestNestedAttributeObjectEqual(t *testing.T) {
 	}
 }
 
+func TestNestedAttributeObjectFromObjectType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           basetypes.ObjectTypable
+		attributes    map[string]schema.Attribute
+		expected      schema.NestedAttributeObject
+		expectedDiags diag.Diagnostics
+	}{
+		"base": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"testattr": types.StringType,
+				},
+			},
+			attributes: map[string]schema.Attribute{
+				"testattr": schema.StringAttribute{},
+			},
+			expected: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+			},
+		},
+		"custom-type": {
+			typ: testtypes.SingleNestedAttributesCustomTypeType{
+				ObjectType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+				},
+			},
+			attributes: map[string]schema.Attribute{
+				"testattr": schema.StringAttribute{},
+			},
+			expected: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+				CustomType: testtypes.SingleNestedAttributesCustomTypeType{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"testattr": types.StringType,
+						},
+					},
+				},
+			},
+		},
+		"inconsistent": {
+			typ: testtypes.SingleNestedAttributesCustomTypeType{
+				ObjectType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"missing_attr":  types.StringType,
+						"mismatch_attr": types.StringType,
+					},
+				},
+			},
+			attributes: map[string]schema.Attribute{
+				"extra_attr":    schema.StringAttribute{},
+				"mismatch_attr": schema.BoolAttribute{},
+			},
+			expected: schema.NestedAttributeObject{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Nested Attribute Object Type",
+					"While creating a nested attribute object, the object type was detected as inconsistent with the attribute definitions. "+
+						"The object type must define exactly the same attribute names and types as the attribute definitions. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"extra_attr: missing attribute of type basetypes.StringType\n"+
+						"mismatch_attr: expected type basetypes.BoolType, got basetypes.StringType\n"+
+						"missing_attr: extra attribute of type basetypes.StringType",
+				),
+			},
+		},
+		"inconsistent-nested": {
+			typ: testtypes.SingleNestedAttributesCustomTypeType{
+				ObjectType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"list_attr": types.ListType{
+							ElemType: types.ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"nested_attr": types.BoolType,
+								},
+							},
+						},
+					},
+				},
+			},
+			attributes: map[string]schema.Attribute{
+				"list_attr": schema.ListNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"nested_attr": schema.StringAttribute{},
+						},
+					},
+				},
+			},
+			expected: schema.NestedAttributeObject{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Nested Attribute Object Type",
+					"While creating a nested attribute object, the object type was detected as inconsistent with the attribute definitions. "+
+						"The object type must define exactly the same attribute names and types as the attribute definitions. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"list_attr[*].nested_attr: expected type basetypes.StringType, got basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schema.NestedAttributeObjectFromObjectType(testCase.typ, testCase.attributes)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNestedAttributeObjectGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -195,6 +328,68 @@ func TestNestedAttributeObjectGetAttributes(t *testing.T) {
 	}
 }
 
+func TestNestedAttributeObjectNullValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		object        schema.NestedAttributeObject
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"no-attributes": {
+			object:   schema.NestedAttributeObject{},
+			expected: types.ObjectNull(map[string]attr.Type{}),
+		},
+		"attributes": {
+			object: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+			},
+			expected: types.ObjectNull(map[string]attr.Type{
+				"testattr": types.StringType,
+			}),
+		},
+		"custom-type": {
+			object: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+				CustomType: testtypes.SingleNestedAttributesCustomTypeType{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"testattr": types.StringType,
+						},
+					},
+				},
+			},
+			expected: testtypes.SingleNestedAttributesCustomValue{
+				Object: types.ObjectNull(map[string]attr.Type{
+					"testattr": types.StringType,
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.object.NullValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNestedAttributeObjectObjectValidators(t *testing.T) {
 	t.Parallel()
 
@@ -274,3 +469,65 @@ func TestNestedAttributeObjectType(t *testing.T) {
 		})
 	}
 }
+
+func TestNestedAttributeObjectUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		object        schema.NestedAttributeObject
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"no-attributes": {
+			object:   schema.NestedAttributeObject{},
+			expected: types.ObjectUnknown(map[string]attr.Type{}),
+		},
+		"attributes": {
+			object: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+			},
+			expected: types.ObjectUnknown(map[string]attr.Type{
+				"testattr": types.StringType,
+			}),
+		},
+		"custom-type": {
+			object: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+				CustomType: testtypes.SingleNestedAttributesCustomTypeType{
+					ObjectType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"testattr": types.StringType,
+						},
+					},
+				},
+			},
+			expected: testtypes.SingleNestedAttributesCustomValue{
+				Object: types.ObjectUnknown(map[string]attr.Type{
+					"testattr": types.StringType,
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.object.UnknownValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
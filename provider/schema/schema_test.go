@@ -1371,6 +1371,65 @@ func TestSchemaValidate(t *testing.T) {
 				),
 			},
 		},
+		"attribute-required": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"attribute-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"attribute-required-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute cannot be both Required and Optional. Use only one of these fields to define whether a configuration value must be set. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"single-nested-attribute-nested-required-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test_attr": schema.StringAttribute{
+								Optional: true,
+								Required: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("single_nested_attribute").AtName("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute cannot be both Required and Optional. Use only one of these fields to define whether a configuration value must be set. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -2,6 +2,8 @@ package resource
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Resource represents an instance of a managed resource type. This is the core
@@ -16,6 +18,7 @@ import (
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
 //   - State Upgrades: ResourceWithUpgradeState
+//   - Warning Suppression: ResourceWithSuppressedWarningPaths
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -150,6 +153,25 @@ type ResourceWithUpgradeState interface {
 	UpgradeState(context.Context) map[int64]StateUpgrader
 }
 
+// ResourceWithSuppressedWarningPaths is an interface type that extends
+// Resource to suppress framework-generated validation warnings, such as
+// attribute and block deprecation warnings, for specific attributes and
+// blocks of the resource configuration. The intended use case is enabling
+// practitioners with large configurations that intentionally use deprecated
+// attributes during a migration to opt out of the warnings.
+//
+// The path expressions are only matched against this resource
+// configuration. Error diagnostics and any diagnostics returned by
+// provider-defined validators are never suppressed.
+type ResourceWithSuppressedWarningPaths interface {
+	Resource
+
+	// SuppressedWarningPaths should return the path expressions of
+	// attributes and blocks which should not raise framework-generated
+	// validation warnings.
+	SuppressedWarningPaths(context.Context) path.Expressions
+}
+
 // ResourceWithValidateConfig is an interface type that extends Resource to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
@@ -75,9 +75,12 @@ type ListNestedBlock struct {
 	MarkdownDescription string
 
 	// DeprecationMessage defines warning diagnostic details to display when
-	// practitioner configurations use this Attribute. The warning diagnostic
-	// summary is automatically set to "Attribute Deprecated" along with
-	// configuration source file and line information.
+	// practitioner configurations use this Block. The warning diagnostic
+	// summary is automatically set to "Block Deprecated" along with
+	// configuration source file and line information. A single warning is
+	// displayed for the whole block, regardless of how many nested block
+	// objects are configured, and any deprecation warnings for attributes
+	// and blocks underneath this block are not displayed.
 	//
 	// Set this field to a practitioner actionable message such as:
 	//
@@ -29,6 +29,10 @@ type NestedBlockObject struct {
 	//
 	// Names must only contain lowercase letters, numbers, and underscores.
 	// Names must not collide with any Blocks names.
+	//
+	// Nested attributes, such as ListNestedAttribute, are only supported by
+	// protocol version 6 servers. Protocol version 5 servers return an error
+	// diagnostic when converting the schema.
 	Attributes map[string]Attribute
 
 	// Blocks is the mapping of underlying block names to block definitions.
@@ -57,6 +57,10 @@ type SetRequest struct {
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
 	// SetResponse.Private to update or remove a value.
+	//
+	// When no private state data was previously stored, such as during
+	// resource creation, the GetKey method returns a nil value without
+	// diagnostics.
 	Private *privatestate.ProviderData
 }
 
@@ -0,0 +1,347 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// SchemaChangeKind is an enum type of the ways an attribute or block can
+// differ between two schemas.
+type SchemaChangeKind uint8
+
+const (
+	// SchemaChangeKindUnknown is an invalid change kind, used to catch when a
+	// change kind is expected and not set.
+	SchemaChangeKindUnknown SchemaChangeKind = 0
+
+	// SchemaChangeKindAdded is for attributes or blocks which are only
+	// present in the new schema.
+	SchemaChangeKindAdded SchemaChangeKind = 1
+
+	// SchemaChangeKindRemoved is for attributes or blocks which are only
+	// present in the old schema.
+	SchemaChangeKindRemoved SchemaChangeKind = 2
+
+	// SchemaChangeKindTypeChanged is for attributes or blocks whose value
+	// type or nesting mode differs between the schemas.
+	SchemaChangeKindTypeChanged SchemaChangeKind = 3
+
+	// SchemaChangeKindConfigurabilityChanged is for attributes whose
+	// Required, Optional, or Computed fields differ between the schemas.
+	SchemaChangeKindConfigurabilityChanged SchemaChangeKind = 4
+)
+
+// String returns a human-readable representation of the change kind.
+func (k SchemaChangeKind) String() string {
+	switch k {
+	case SchemaChangeKindAdded:
+		return "added"
+	case SchemaChangeKindRemoved:
+		return "removed"
+	case SchemaChangeKindTypeChanged:
+		return "type changed"
+	case SchemaChangeKindConfigurabilityChanged:
+		return "configurability changed"
+	default:
+		return "unknown"
+	}
+}
+
+// SchemaChange describes a single difference between two schemas.
+type SchemaChange struct {
+	// Path is the path of the attribute or block which changed. Paths of
+	// nested attributes and blocks under a list, map, or set use the
+	// AtAnyListIndex, AtAnyMapKey, or AtAnySetValue expression steps.
+	Path path.Expression
+
+	// Kind is the kind of change.
+	Kind SchemaChangeKind
+
+	// Breaking is true if the change is potentially breaking for existing
+	// configurations or state, such as a removed attribute or type change.
+	Breaking bool
+
+	// Description is a human-readable description of the change.
+	Description string
+}
+
+// SchemaDiff is the result of comparing two schemas via DiffSchemas.
+type SchemaDiff struct {
+	// Changes contains every difference between the schemas, ordered by
+	// path.
+	Changes []SchemaChange
+}
+
+// BreakingChanges returns only the potentially breaking changes.
+func (d SchemaDiff) BreakingChanges() []SchemaChange {
+	var result []SchemaChange
+
+	for _, change := range d.Changes {
+		if change.Breaking {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
+
+// HasBreakingChanges returns true if any change is potentially breaking.
+func (d SchemaDiff) HasBreakingChanges() bool {
+	return len(d.BreakingChanges()) > 0
+}
+
+// DiffSchemas compares two versions of a resource schema and returns every
+// added attribute or block, removed attribute or block, type change, and
+// Required, Optional, or Computed transition. Nested attributes and blocks
+// are compared recursively. This is intended for provider test suites which
+// verify schema compatibility across releases.
+//
+// Changes are classified as potentially breaking when existing
+// configurations or state may no longer be valid or may produce
+// differences, which includes:
+//
+//   - Removed attributes or blocks.
+//   - Added Required attributes.
+//   - Attribute or block type or nesting mode changes.
+//   - Attributes becoming Required, no longer being configurable, or no
+//     longer being Computed.
+//
+// All other changes, such as added Optional or Computed attributes, added
+// blocks, or Required attributes becoming Optional, are classified as safe.
+// Descriptions, validators, and plan modifiers are not compared.
+func DiffSchemas(old, new Schema) SchemaDiff {
+	var diff SchemaDiff
+
+	diff.Changes = append(diff.Changes, diffAttributes(path.MatchRoot, old.GetAttributes(), new.GetAttributes())...)
+	diff.Changes = append(diff.Changes, diffBlocks(path.MatchRoot, old.GetBlocks(), new.GetBlocks())...)
+
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Path.String() < diff.Changes[j].Path.String()
+	})
+
+	return diff
+}
+
+// diffAttributes returns the changes between two sets of attributes at the
+// same level of a schema. The root function is used to create the
+// expression for the given attribute name.
+func diffAttributes(root func(string) path.Expression, old, new map[string]fwschema.Attribute) []SchemaChange {
+	var changes []SchemaChange
+
+	for _, name := range sortedAttributeNames(old, new) {
+		oldAttribute, oldOk := old[name]
+		newAttribute, newOk := new[name]
+		expression := root(name)
+
+		switch {
+		case !newOk:
+			changes = append(changes, SchemaChange{
+				Path:        expression,
+				Kind:        SchemaChangeKindRemoved,
+				Breaking:    true,
+				Description: fmt.Sprintf("Attribute %s was removed.", expression),
+			})
+		case !oldOk:
+			changes = append(changes, SchemaChange{
+				Path:        expression,
+				Kind:        SchemaChangeKindAdded,
+				Breaking:    newAttribute.IsRequired(),
+				Description: fmt.Sprintf("Attribute %s was added.", expression),
+			})
+		default:
+			changes = append(changes, diffAttribute(expression, oldAttribute, newAttribute)...)
+		}
+	}
+
+	return changes
+}
+
+// diffAttribute returns the changes between two versions of the same
+// attribute.
+func diffAttribute(expression path.Expression, old, new fwschema.Attribute) []SchemaChange {
+	var changes []SchemaChange
+
+	if change, ok := diffConfigurability(expression, old, new); ok {
+		changes = append(changes, change)
+	}
+
+	oldNested, oldIsNested := old.(fwschema.NestedAttribute)
+	newNested, newIsNested := new.(fwschema.NestedAttribute)
+
+	if oldIsNested && newIsNested && oldNested.GetNestingMode() == newNested.GetNestingMode() {
+		nestedRoot := nestedAttributeExpression(expression, newNested.GetNestingMode())
+
+		changes = append(changes, diffAttributes(nestedRoot, oldNested.GetNestedObject().GetAttributes(), newNested.GetNestedObject().GetAttributes())...)
+
+		return changes
+	}
+
+	if !old.GetType().Equal(new.GetType()) {
+		changes = append(changes, SchemaChange{
+			Path:        expression,
+			Kind:        SchemaChangeKindTypeChanged,
+			Breaking:    true,
+			Description: fmt.Sprintf("Attribute %s type changed from %s to %s.", expression, old.GetType(), new.GetType()),
+		})
+	}
+
+	return changes
+}
+
+// diffConfigurability returns the change, if any, between the Required,
+// Optional, and Computed fields of two versions of the same attribute.
+func diffConfigurability(expression path.Expression, old, new fwschema.Attribute) (SchemaChange, bool) {
+	oldConfigurability := attributeConfigurability(old)
+	newConfigurability := attributeConfigurability(new)
+
+	if oldConfigurability == newConfigurability {
+		return SchemaChange{}, false
+	}
+
+	oldConfigurable := old.IsRequired() || old.IsOptional()
+	newConfigurable := new.IsRequired() || new.IsOptional()
+
+	breaking := (!old.IsRequired() && new.IsRequired()) ||
+		(oldConfigurable && !newConfigurable) ||
+		(old.IsComputed() && !new.IsComputed())
+
+	return SchemaChange{
+		Path:        expression,
+		Kind:        SchemaChangeKindConfigurabilityChanged,
+		Breaking:    breaking,
+		Description: fmt.Sprintf("Attribute %s changed from %s to %s.", expression, oldConfigurability, newConfigurability),
+	}, true
+}
+
+// attributeConfigurability returns a human-readable representation of the
+// Required, Optional, and Computed fields of an attribute.
+func attributeConfigurability(a fwschema.Attribute) string {
+	switch {
+	case a.IsRequired():
+		return "required"
+	case a.IsOptional() && a.IsComputed():
+		return "optional and computed"
+	case a.IsOptional():
+		return "optional"
+	case a.IsComputed():
+		return "computed"
+	default:
+		return "not configurable"
+	}
+}
+
+// diffBlocks returns the changes between two sets of blocks at the same level
+// of a schema. The root function is used to create the expression for the
+// given block name.
+func diffBlocks(root func(string) path.Expression, old, new map[string]fwschema.Block) []SchemaChange {
+	var changes []SchemaChange
+
+	for _, name := range sortedBlockNames(old, new) {
+		oldBlock, oldOk := old[name]
+		newBlock, newOk := new[name]
+		expression := root(name)
+
+		switch {
+		case !newOk:
+			changes = append(changes, SchemaChange{
+				Path:        expression,
+				Kind:        SchemaChangeKindRemoved,
+				Breaking:    true,
+				Description: fmt.Sprintf("Block %s was removed.", expression),
+			})
+		case !oldOk:
+			changes = append(changes, SchemaChange{
+				Path:        expression,
+				Kind:        SchemaChangeKindAdded,
+				Description: fmt.Sprintf("Block %s was added.", expression),
+			})
+		case oldBlock.GetNestingMode() != newBlock.GetNestingMode():
+			changes = append(changes, SchemaChange{
+				Path:        expression,
+				Kind:        SchemaChangeKindTypeChanged,
+				Breaking:    true,
+				Description: fmt.Sprintf("Block %s type changed from %s to %s.", expression, oldBlock.Type(), newBlock.Type()),
+			})
+		default:
+			nestedRoot := nestedBlockExpression(expression, newBlock.GetNestingMode())
+			oldObject := oldBlock.GetNestedObject()
+			newObject := newBlock.GetNestedObject()
+
+			changes = append(changes, diffAttributes(nestedRoot, oldObject.GetAttributes(), newObject.GetAttributes())...)
+			changes = append(changes, diffBlocks(nestedRoot, oldObject.GetBlocks(), newObject.GetBlocks())...)
+		}
+	}
+
+	return changes
+}
+
+// nestedAttributeExpression returns a function which creates the expression
+// for an attribute underneath the given nested attribute.
+func nestedAttributeExpression(expression path.Expression, nestingMode fwschema.NestingMode) func(string) path.Expression {
+	switch nestingMode {
+	case fwschema.NestingModeList:
+		expression = expression.AtAnyListIndex()
+	case fwschema.NestingModeMap:
+		expression = expression.AtAnyMapKey()
+	case fwschema.NestingModeSet:
+		expression = expression.AtAnySetValue()
+	}
+
+	return expression.AtName
+}
+
+// nestedBlockExpression returns a function which creates the expression for
+// an attribute or block underneath the given block.
+func nestedBlockExpression(expression path.Expression, nestingMode fwschema.BlockNestingMode) func(string) path.Expression {
+	switch nestingMode {
+	case fwschema.BlockNestingModeList:
+		expression = expression.AtAnyListIndex()
+	case fwschema.BlockNestingModeSet:
+		expression = expression.AtAnySetValue()
+	}
+
+	return expression.AtName
+}
+
+// sortedAttributeNames returns the sorted union of the names of two sets of
+// attributes.
+func sortedAttributeNames(old, new map[string]fwschema.Attribute) []string {
+	names := make([]string, 0, len(old)+len(new))
+
+	for name := range old {
+		names = append(names, name)
+	}
+
+	for name := range new {
+		if _, ok := old[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// sortedBlockNames returns the sorted union of the names of two sets of
+// blocks.
+func sortedBlockNames(old, new map[string]fwschema.Block) []string {
+	names := make([]string, 0, len(old)+len(new))
+
+	for name := range old {
+		names = append(names, name)
+	}
+
+	for name := range new {
+		if _, ok := old[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
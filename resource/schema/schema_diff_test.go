@@ -0,0 +1,466 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old      schema.Schema
+		new      schema.Schema
+		expected schema.SchemaDiff
+	}{
+		"no-changes": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required:    true,
+						Description: "descriptions are not compared",
+					},
+				},
+			},
+			expected: schema.SchemaDiff{},
+		},
+		"attribute-added-optional": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+					"test_new": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_new"),
+						Kind:        schema.SchemaChangeKindAdded,
+						Breaking:    false,
+						Description: "Attribute test_new was added.",
+					},
+				},
+			},
+		},
+		"attribute-added-required": {
+			old: schema.Schema{},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_new": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_new"),
+						Kind:        schema.SchemaChangeKindAdded,
+						Breaking:    true,
+						Description: "Attribute test_new was added.",
+					},
+				},
+			},
+		},
+		"attribute-removed": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+					"test_old": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_old"),
+						Kind:        schema.SchemaChangeKindRemoved,
+						Breaking:    true,
+						Description: "Attribute test_old was removed.",
+					},
+				},
+			},
+		},
+		"attribute-type-changed": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_attr"),
+						Kind:        schema.SchemaChangeKindTypeChanged,
+						Breaking:    true,
+						Description: "Attribute test_attr type changed from basetypes.StringType to basetypes.Int64Type.",
+					},
+				},
+			},
+		},
+		"attribute-required-to-optional": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_attr"),
+						Kind:        schema.SchemaChangeKindConfigurabilityChanged,
+						Breaking:    false,
+						Description: "Attribute test_attr changed from required to optional.",
+					},
+				},
+			},
+		},
+		"attribute-optional-to-required": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_attr"),
+						Kind:        schema.SchemaChangeKindConfigurabilityChanged,
+						Breaking:    true,
+						Description: "Attribute test_attr changed from optional to required.",
+					},
+				},
+			},
+		},
+		"attribute-optional-computed-to-optional": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_attr"),
+						Kind:        schema.SchemaChangeKindConfigurabilityChanged,
+						Breaking:    true,
+						Description: "Attribute test_attr changed from optional and computed to optional.",
+					},
+				},
+			},
+		},
+		"nested-attribute-changes": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+								"nested_old": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.BoolAttribute{
+									Optional: true,
+								},
+								"nested_new": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_attr").AtAnyListIndex().AtName("nested_attr"),
+						Kind:        schema.SchemaChangeKindTypeChanged,
+						Breaking:    true,
+						Description: "Attribute test_attr[*].nested_attr type changed from basetypes.StringType to basetypes.BoolType.",
+					},
+					{
+						Path:        path.MatchRoot("test_attr").AtAnyListIndex().AtName("nested_new"),
+						Kind:        schema.SchemaChangeKindAdded,
+						Breaking:    false,
+						Description: "Attribute test_attr[*].nested_new was added.",
+					},
+					{
+						Path:        path.MatchRoot("test_attr").AtAnyListIndex().AtName("nested_old"),
+						Kind:        schema.SchemaChangeKindRemoved,
+						Breaking:    true,
+						Description: "Attribute test_attr[*].nested_old was removed.",
+					},
+				},
+			},
+		},
+		"nested-attribute-nesting-mode-changed": {
+			old: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			new: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_attr"),
+						Kind:        schema.SchemaChangeKindTypeChanged,
+						Breaking:    true,
+						Description: "Attribute test_attr type changed from types.ListType[types.ObjectType[\"nested_attr\":basetypes.StringType]] to types.SetType[types.ObjectType[\"nested_attr\":basetypes.StringType]].",
+					},
+				},
+			},
+		},
+		"block-changes": {
+			old: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+					"test_old": schema.SingleNestedBlock{},
+				},
+			},
+			new: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"nested_block": schema.SingleNestedBlock{
+									Attributes: map[string]schema.Attribute{
+										"nested_block_attr": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_block").AtAnyListIndex().AtName("nested_block"),
+						Kind:        schema.SchemaChangeKindAdded,
+						Breaking:    false,
+						Description: "Block test_block[*].nested_block was added.",
+					},
+					{
+						Path:        path.MatchRoot("test_old"),
+						Kind:        schema.SchemaChangeKindRemoved,
+						Breaking:    true,
+						Description: "Block test_old was removed.",
+					},
+				},
+			},
+		},
+		"block-nesting-mode-changed": {
+			old: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block": schema.ListNestedBlock{},
+				},
+			},
+			new: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block": schema.SetNestedBlock{},
+				},
+			},
+			expected: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:        path.MatchRoot("test_block"),
+						Kind:        schema.SchemaChangeKindTypeChanged,
+						Breaking:    true,
+						Description: "Block test_block type changed from types.ListType[types.ObjectType[]] to types.SetType[types.ObjectType[]].",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schema.DiffSchemas(testCase.old, testCase.new)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaDiffHasBreakingChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diff     schema.SchemaDiff
+		expected bool
+	}{
+		"empty": {
+			diff:     schema.SchemaDiff{},
+			expected: false,
+		},
+		"safe": {
+			diff: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:     path.MatchRoot("test"),
+						Kind:     schema.SchemaChangeKindAdded,
+						Breaking: false,
+					},
+				},
+			},
+			expected: false,
+		},
+		"breaking": {
+			diff: schema.SchemaDiff{
+				Changes: []schema.SchemaChange{
+					{
+						Path:     path.MatchRoot("test"),
+						Kind:     schema.SchemaChangeKindAdded,
+						Breaking: false,
+					},
+					{
+						Path:     path.MatchRoot("test_old"),
+						Kind:     schema.SchemaChangeKindRemoved,
+						Breaking: true,
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.diff.HasBreakingChanges()
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
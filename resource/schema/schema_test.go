@@ -12,8 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -701,6 +706,37 @@ func TestSchemaType(t *testing.T) {
 				},
 			},
 		},
+		"attribute-aliases": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Aliases:  []string{"old_testattr"},
+						Optional: true,
+					},
+					"testnested": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"nestedattr": schema.BoolAttribute{
+								Aliases:  []string{"old_nestedattr"},
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"old_testattr": types.StringType,
+					"testattr":     types.StringType,
+					"testnested": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nestedattr":     types.BoolType,
+							"old_nestedattr": types.BoolType,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -757,6 +793,19 @@ func TestSchemaTypeAtPath(t *testing.T) {
 			path:     path.Root("string"),
 			expected: types.StringType,
 		},
+		"AttributeName-Attribute-alias": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"bool": schema.BoolAttribute{},
+					"string": schema.StringAttribute{
+						Aliases:  []string{"old_string"},
+						Optional: true,
+					},
+				},
+			},
+			path:     path.Root("old_string"),
+			expected: types.StringType,
+		},
 		"AttributeName-Block": {
 			schema: schema.Schema{
 				Blocks: map[string]schema.Block{
@@ -1380,6 +1429,207 @@ func TestSchemaValidate(t *testing.T) {
 				),
 			},
 		},
+		"attribute-required": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"attribute-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"attribute-required-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute cannot be both Required and Optional. Use only one of these fields to define whether a configuration value must be set. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+		"attribute-optional-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"attribute-aliases-required": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Aliases:  []string{"old_test_attr"},
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute with Aliases must be Optional and cannot be Required. Practitioners may configure either the attribute or one of its aliases, "+
+						"so neither name can be enforced as required by Terraform. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-aliases-conflict-attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"other_attr": schema.StringAttribute{
+						Optional: true,
+					},
+					"test_attr": schema.StringAttribute{
+						Aliases:  []string{"other_attr"},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute alias \"other_attr\" conflicts with an existing attribute or block of the same name. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-aliases-conflict-alias": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr1": schema.StringAttribute{
+						Aliases:  []string{"old_test_attr"},
+						Optional: true,
+					},
+					"test_attr2": schema.StringAttribute{
+						Aliases:  []string{"old_test_attr"},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr2"),
+					"Invalid Attribute Definition",
+					"Attribute alias \"old_test_attr\" is already declared by the test_attr1 attribute. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-aliases-invalid-name": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Aliases:  []string{"old-test-attr"},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr"),
+					"Invalid Schema Field Name",
+					"Field name \"old-test-attr\" is invalid, the only allowed characters are a-z, 0-9 and _. This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"attribute-required-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute cannot be both Required and Computed. Required attributes must be configured by practitioners, while Computed attributes without Optional are set by the provider. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"list-nested-attribute-nested-required-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list_nested_attribute": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attr": schema.StringAttribute{
+									Computed: true,
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list_nested_attribute").AtName("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute cannot be both Required and Computed. Required attributes must be configured by practitioners, while Computed attributes without Optional are set by the provider. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"list-nested-block-nested-required-optional": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list_nested_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attr": schema.StringAttribute{
+									Optional: true,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list_nested_block").AtName("test_attr"),
+					"Invalid Attribute Definition",
+					"Attribute cannot be both Required and Optional. Use only one of these fields to define whether a configuration value must be set. "+
+						"This is always a problem with the provider and should be reported to the provider developer.",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1394,3 +1644,134 @@ func TestSchemaValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaValidators(t *testing.T) {
+	t.Parallel()
+
+	testObjectValidator := testvalidator.Object{
+		DescriptionMethod: func(_ context.Context) string {
+			return "test object description"
+		},
+	}
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected map[string][]string
+	}{
+		"no-validators": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attribute": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: map[string][]string{},
+		},
+		"attribute-multiple-validators": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attribute": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.HasPrefix("https://"),
+							stringvalidator.Contains("example"),
+						},
+					},
+					"other_attribute": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.Positive(),
+						},
+					},
+				},
+			},
+			expected: map[string][]string{
+				"test_attribute": {
+					`value must start with "https://"`,
+					`value must contain "example"`,
+				},
+				"other_attribute": {
+					"value must be greater than 0",
+				},
+			},
+		},
+		"nested-attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attribute": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attribute": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.HasPrefix("https://"),
+									},
+								},
+							},
+							Validators: []validator.Object{
+								testObjectValidator,
+							},
+						},
+						Optional: true,
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+						},
+					},
+				},
+			},
+			expected: map[string][]string{
+				"test_attribute": {
+					"element values must be unique",
+				},
+				"test_attribute[*]": {
+					"test object description",
+				},
+				"test_attribute[*].nested_attribute": {
+					`value must start with "https://"`,
+				},
+			},
+		},
+		"block": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"nested_attribute": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.Positive(),
+								},
+							},
+						},
+						Validators: []validator.Object{
+							testObjectValidator,
+						},
+					},
+				},
+			},
+			expected: map[string][]string{
+				"test_block": {
+					"test object description",
+				},
+				"test_block.nested_attribute": {
+					"value must be greater than 0",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.Validators(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -50,6 +50,10 @@ type SingleNestedBlock struct {
 	//
 	// Names must only contain lowercase letters, numbers, and underscores.
 	// Names must not collide with any Blocks names.
+	//
+	// Nested attributes, such as ListNestedAttribute, are only supported by
+	// protocol version 6 servers. Protocol version 5 servers return an error
+	// diagnostic when converting the schema.
 	Attributes map[string]Attribute
 
 	// Blocks is the mapping of underlying block names to block definitions.
@@ -76,9 +80,12 @@ type SingleNestedBlock struct {
 	MarkdownDescription string
 
 	// DeprecationMessage defines warning diagnostic details to display when
-	// practitioner configurations use this Attribute. The warning diagnostic
-	// summary is automatically set to "Attribute Deprecated" along with
-	// configuration source file and line information.
+	// practitioner configurations use this Block. The warning diagnostic
+	// summary is automatically set to "Block Deprecated" along with
+	// configuration source file and line information. A single warning is
+	// displayed for the whole block, regardless of how many nested block
+	// objects are configured, and any deprecation warnings for attributes
+	// and blocks underneath this block are not displayed.
 	//
 	// Set this field to a practitioner actionable message such as:
 	//
@@ -154,6 +161,10 @@ func (b SingleNestedBlock) ApplyTerraform5AttributePathStep(step tftypes.Attribu
 		return attribute, nil
 	}
 
+	if attribute, ok := fwschema.AttributeNamed(schemaAttributes(b.Attributes), string(name)); ok {
+		return attribute, nil
+	}
+
 	if block, ok := b.Blocks[string(name)]; ok {
 		return block, nil
 	}
@@ -222,6 +233,10 @@ func (b SingleNestedBlock) Type() attr.Type {
 
 	for name, attribute := range b.Attributes {
 		attrTypes[name] = attribute.GetType()
+
+		for _, alias := range fwschema.AttributeAliases(attribute) {
+			attrTypes[alias] = attrTypes[name]
+		}
 	}
 
 	for name, block := range b.Blocks {
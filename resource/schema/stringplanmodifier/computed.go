@@ -0,0 +1,171 @@
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Computed returns a plan modifier that sets an unconfigured and unknown
+// planned value to the result of evaluating the given expression, such as
+// combining other attribute values into a name. Use this with Optional and
+// Computed attributes where the default value is derived from other
+// attributes of the resource.
+//
+// The expression is one or more terms concatenated with the + operator, where
+// each term is either a double quoted string literal or a reference to a
+// sibling attribute by name, such as:
+//
+//	name + "-" + region
+//
+// References can traverse nested object attributes with the . operator, such
+// as settings.region, and must refer to string attributes. The planned values
+// of referenced attributes are used. If any referenced value is null, the
+// planned value is set to null. Otherwise, if any referenced value is unknown
+// or is underneath a null or unknown nested object, the planned value is left
+// unknown. References are not checked against the schema until planning, so
+// a reference to an attribute which does not exist in the schema returns an
+// error diagnostic during every plan.
+//
+// Computed panics if the expression is invalid, since it is defined as part of
+// the schema and can never succeed.
+func Computed(expr string) planmodifier.String {
+	terms, err := parseComputedExpression(expr)
+
+	if err != nil {
+		panic(fmt.Sprintf("invalid Computed expression %q: %s", expr, err))
+	}
+
+	return computedModifier{
+		expr:  expr,
+		terms: terms,
+	}
+}
+
+// computedModifier implements the plan modifier.
+type computedModifier struct {
+	expr  string
+	terms []computedExpressionTerm
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m computedModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("If not configured, the value is computed from the expression: %s", m.expr)
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m computedModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("If not configured, the value is computed from the expression: `%s`", m.expr)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m computedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is a configuration value.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var result strings.Builder
+	var hasNull, hasUnknown bool
+
+	for _, term := range m.terms {
+		if term.reference == nil {
+			result.WriteString(term.literal)
+
+			continue
+		}
+
+		expression := req.PathExpression.Merge(*term.reference)
+
+		matchedPaths, diags := req.Plan.PathMatches(ctx, expression)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		// Parent paths are matched when they are null or unknown, in which
+		// case the reference cannot be resolved.
+		var matchedValues []attr.Value
+
+		for _, matchedPath := range matchedPaths {
+			if !expression.Matches(matchedPath) {
+				continue
+			}
+
+			var matchedValue attr.Value
+
+			diags := req.Plan.GetAttribute(ctx, matchedPath, &matchedValue)
+
+			resp.Diagnostics.Append(diags...)
+
+			if diags.HasError() {
+				return
+			}
+
+			matchedValues = append(matchedValues, matchedValue)
+		}
+
+		if len(matchedValues) != 1 {
+			hasUnknown = true
+
+			continue
+		}
+
+		stringValuable, ok := matchedValues[0].(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Computed Expression Reference",
+				"An unexpected value type was encountered while attempting to evaluate the computed expression. "+
+					"Referenced attributes must be string attributes. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Reference: %s\n", expression)+
+					fmt.Sprintf("Value Type: %T", matchedValues[0]),
+			)
+
+			return
+		}
+
+		stringValue, diags := stringValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		switch {
+		case stringValue.IsNull():
+			hasNull = true
+		case stringValue.IsUnknown():
+			hasUnknown = true
+		default:
+			result.WriteString(stringValue.ValueString())
+		}
+	}
+
+	if hasNull {
+		resp.PlanValue = types.StringNull()
+
+		return
+	}
+
+	if hasUnknown {
+		return
+	}
+
+	resp.PlanValue = types.StringValue(result.String())
+}
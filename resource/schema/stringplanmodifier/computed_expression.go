@@ -0,0 +1,126 @@
+package stringplanmodifier
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// computedExpressionTerm is a single term of a computed expression, which is
+// either a string literal or a reference to another attribute.
+type computedExpressionTerm struct {
+	// literal is the value of a string literal term.
+	literal string
+
+	// reference is the path expression of a reference term, relative to
+	// the attribute being modified. It is nil for string literal terms.
+	reference *path.Expression
+}
+
+// parseComputedExpression returns the terms of a computed expression. The
+// expression grammar is one or more terms separated by the + concatenation
+// operator, where each term is either a double quoted string literal with Go
+// escape sequences or a reference to a sibling attribute name. References
+// may traverse nested object attributes with the . operator.
+func parseComputedExpression(expr string) ([]computedExpressionTerm, error) {
+	var terms []computedExpressionTerm
+
+	remaining := strings.TrimSpace(expr)
+
+	if remaining == "" {
+		return nil, fmt.Errorf("expression must contain at least one term")
+	}
+
+	for {
+		term, rest, err := parseComputedExpressionTerm(remaining)
+
+		if err != nil {
+			return nil, err
+		}
+
+		terms = append(terms, term)
+		remaining = strings.TrimSpace(rest)
+
+		if remaining == "" {
+			return terms, nil
+		}
+
+		if remaining[0] != '+' {
+			return nil, fmt.Errorf("expected + operator at %q", remaining)
+		}
+
+		remaining = strings.TrimSpace(remaining[1:])
+	}
+}
+
+// parseComputedExpressionTerm returns the term at the start of the input and
+// the remaining input.
+func parseComputedExpressionTerm(input string) (computedExpressionTerm, string, error) {
+	if input == "" {
+		return computedExpressionTerm{}, "", fmt.Errorf("expected term after + operator")
+	}
+
+	if input[0] == '"' {
+		return parseComputedExpressionLiteral(input)
+	}
+
+	return parseComputedExpressionReference(input)
+}
+
+// parseComputedExpressionLiteral returns the string literal term at the start
+// of the input and the remaining input.
+func parseComputedExpressionLiteral(input string) (computedExpressionTerm, string, error) {
+	for end := 1; end < len(input); end++ {
+		switch input[end] {
+		case '\\':
+			// Skip the escaped character, which may be a quote.
+			end++
+		case '"':
+			literal, err := strconv.Unquote(input[:end+1])
+
+			if err != nil {
+				return computedExpressionTerm{}, "", fmt.Errorf("invalid string literal %s: %w", input[:end+1], err)
+			}
+
+			return computedExpressionTerm{literal: literal}, input[end+1:], nil
+		}
+	}
+
+	return computedExpressionTerm{}, "", fmt.Errorf("unterminated string literal %s", input)
+}
+
+// parseComputedExpressionReference returns the attribute reference term at
+// the start of the input and the remaining input. The reference is relative
+// to the parent of the attribute being modified, so it refers to siblings.
+func parseComputedExpressionReference(input string) (computedExpressionTerm, string, error) {
+	expression := path.MatchRelative().AtParent()
+	remaining := input
+
+	for {
+		end := strings.IndexFunc(remaining, func(r rune) bool {
+			return !(r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r))
+		})
+
+		if end == -1 {
+			end = len(remaining)
+		}
+
+		name := remaining[:end]
+
+		if name == "" || unicode.IsDigit(rune(name[0])) {
+			return computedExpressionTerm{}, "", fmt.Errorf("expected string literal or attribute reference at %q", input)
+		}
+
+		expression = expression.AtName(name)
+		remaining = remaining[end:]
+
+		if !strings.HasPrefix(remaining, ".") {
+			return computedExpressionTerm{reference: &expression}, remaining, nil
+		}
+
+		remaining = remaining[1:]
+	}
+}
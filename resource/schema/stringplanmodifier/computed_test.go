@@ -0,0 +1,262 @@
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComputedModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"count": schema.Int64Attribute{
+				Optional: true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"zone": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testPlan := func(name string, region interface{}) tfsdk.Plan {
+		settingsType := tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"zone": tftypes.String,
+			},
+		}
+
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				testSchema.Type().TerraformType(context.Background()),
+				map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"name":   tftypes.NewValue(tftypes.String, name),
+					"region": tftypes.NewValue(tftypes.String, region),
+					"count":  tftypes.NewValue(tftypes.Number, 1),
+					"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+						"zone": tftypes.NewValue(tftypes.String, "a"),
+					}),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		expr     string
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"concatenation": {
+			expr: `name + "-" + region`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", "us-east-1"),
+				PlanValue:      types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test-us-east-1"),
+			},
+		},
+		"concatenation-nested-reference": {
+			expr: `name+"."+settings.zone`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", "us-east-1"),
+				PlanValue:      types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test.a"),
+			},
+		},
+		"literal-escape": {
+			expr: `"say \"hi\""`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", "us-east-1"),
+				PlanValue:      types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue(`say "hi"`),
+			},
+		},
+		"reference-unknown": {
+			expr: `name + "-" + region`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", tftypes.UnknownValue),
+				PlanValue:      types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"reference-null": {
+			expr: `name + "-" + region`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", nil),
+				PlanValue:      types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"reference-invalid-type": {
+			expr: `name + "-" + count`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", "us-east-1"),
+				PlanValue:      types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("id"),
+						"Invalid Computed Expression Reference",
+						"An unexpected value type was encountered while attempting to evaluate the computed expression. "+
+							"Referenced attributes must be string attributes. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Reference: id.<.count\n"+
+							"Value Type: basetypes.Int64Value",
+					),
+				},
+			},
+		},
+		"reference-nonexistent": {
+			expr: `name + "-" + regoin`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", "us-east-1"),
+				PlanValue:      types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Path Expression for Schema Data",
+						"The Terraform Provider unexpectedly matched no paths with the given path expression and current schema data. "+
+							"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path Expression: id.<.regoin",
+					),
+				},
+			},
+		},
+		"configured": {
+			expr: `name + "-" + region`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringValue("configured"),
+				Plan:           testPlan("test", "us-east-1"),
+				PlanValue:      types.StringValue("configured"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("configured"),
+			},
+		},
+		"known-plan": {
+			expr: `name + "-" + region`,
+			request: planmodifier.StringRequest{
+				Path:           path.Root("id"),
+				PathExpression: path.MatchRoot("id"),
+				ConfigValue:    types.StringNull(),
+				Plan:           testPlan("test", "us-east-1"),
+				PlanValue:      types.StringValue("prior"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("prior"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.Computed(testCase.expr).PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestComputedInvalidExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"empty":                  "",
+		"missing-operator":       `name "suffix"`,
+		"trailing-operator":      `name +`,
+		"unterminated-literal":   `name + "suffix`,
+		"invalid-reference":      `name + 1region`,
+		"invalid-reference-step": `settings.`,
+	}
+
+	for name, expr := range testCases {
+		name, expr := name, expr
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected panic, got none")
+				}
+			}()
+
+			stringplanmodifier.Computed(expr)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// BetweenExclusive returns a validator which ensures that any configured
+// float64 value is strictly greater than the given minimum and strictly less
+// than the given maximum, such as a ratio strictly between 0 and 1. Null and
+// unknown values are skipped.
+//
+// BetweenExclusive panics if min is not less than max, since no value could
+// be valid.
+func BetweenExclusive(min, max float64) validator.Float64 {
+	if !(min < max) {
+		panic(fmt.Sprintf("invalid BetweenExclusive bounds: min (%s) must be less than max (%s)", formatFloat64(min), formatFloat64(max)))
+	}
+
+	return betweenExclusiveValidator{
+		max: max,
+		min: min,
+	}
+}
+
+// betweenExclusiveValidator implements the validator.
+type betweenExclusiveValidator struct {
+	max float64
+	min float64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v betweenExclusiveValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be greater than %s and less than %s", formatFloat64(v.min), formatFloat64(v.max))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v betweenExclusiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 implements the validation logic.
+func (v betweenExclusiveValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if !(value > v.min && value < v.max) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), formatFloat64(value)),
+		)
+	}
+}
@@ -0,0 +1,216 @@
+package float64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBetweenExclusiveValidateFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.Float64Request
+		expected *validator.Float64Response
+	}{
+		"null": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Null(),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"unknown": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Unknown(),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"inside": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(0.5),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"inside-near-min": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(0.000001),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"min": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(0),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: 0",
+					),
+				},
+			},
+		},
+		"max": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: 1",
+					),
+				},
+			},
+		},
+		"above-max-imprecise": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1.30000000000000004),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: 1.3",
+					),
+				},
+			},
+		},
+		"above-max-near-max": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1.0000001),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: 1.0000001",
+					),
+				},
+			},
+		},
+		"below-min-near-min": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(-1e-7),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: -0.0000001",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.Float64Response{}
+
+			float64validator.BetweenExclusive(0, 1).ValidateFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBetweenExclusiveInvalidBounds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min float64
+		max float64
+	}{
+		"equal": {
+			min: 1,
+			max: 1,
+		},
+		"min-greater": {
+			min: 2,
+			max: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected panic, got none")
+				}
+			}()
+
+			float64validator.BetweenExclusive(testCase.min, testCase.max)
+		})
+	}
+}
+
+func TestBetweenExclusiveDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min      float64
+		max      float64
+		expected string
+	}{
+		"integers": {
+			min:      0,
+			max:      1,
+			expected: "value must be greater than 0 and less than 1",
+		},
+		"imprecise": {
+			min:      0.1,
+			max:      0.30000000000000004,
+			expected: "value must be greater than 0.1 and less than 0.30000000000000004",
+		},
+		"max-near-min": {
+			min:      0,
+			max:      1e-7,
+			expected: "value must be greater than 0 and less than 0.0000001",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := float64validator.BetweenExclusive(testCase.min, testCase.max).Description(context.Background())
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
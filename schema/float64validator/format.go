@@ -0,0 +1,13 @@
+package float64validator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// formatFloat64 returns a readable representation of the float64 value for
+// validator descriptions and diagnostics, such as "0.3" rather than
+// "0.300000". The fewest digits which represent the value exactly are used,
+// so values near a bound are never rounded onto it.
+func formatFloat64(value float64) string {
+	return basetypes.NewFloat64Value(value).StringWithPrecision(-1)
+}
@@ -0,0 +1,45 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// NonNegative returns a validator which ensures that any configured float64 value
+// is zero or greater. Null and unknown values are skipped.
+func NonNegative() validator.Float64 {
+	return nonNegativeValidator{}
+}
+
+// nonNegativeValidator implements the validator.
+type nonNegativeValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v nonNegativeValidator) Description(_ context.Context) string {
+	return "value must be at least 0"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v nonNegativeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 implements the validation logic.
+func (v nonNegativeValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+
+	if value < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), formatFloat64(value)),
+		)
+	}
+}
@@ -0,0 +1,113 @@
+package float64validator_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPositiveValidateFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.Float64Request
+		expected *validator.Float64Response
+	}{
+		"null": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Null(),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"unknown": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Unknown(),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"1": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"0": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(0),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0, got: 0",
+					),
+				},
+			},
+		},
+		"negative-1": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(-1),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0, got: -1",
+					),
+				},
+			},
+		},
+		"smallest-nonzero": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(math.SmallestNonzeroFloat64),
+			},
+			expected: &validator.Float64Response{},
+		},
+		"negative-near-zero": {
+			request: validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(-1e-7),
+			},
+			expected: &validator.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0, got: -0.0000001",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.Float64Response{}
+
+			float64validator.Positive().ValidateFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// BetweenExclusive returns a validator which ensures that any configured
+// int64 value is strictly greater than the given minimum and strictly less
+// than the given maximum. Null and unknown values are skipped.
+//
+// BetweenExclusive panics if min is not less than max, since no value could
+// be valid.
+func BetweenExclusive(min, max int64) validator.Int64 {
+	if min >= max {
+		panic(fmt.Sprintf("invalid BetweenExclusive bounds: min (%d) must be less than max (%d)", min, max))
+	}
+
+	return betweenExclusiveValidator{
+		max: max,
+		min: min,
+	}
+}
+
+// betweenExclusiveValidator implements the validator.
+type betweenExclusiveValidator struct {
+	max int64
+	min int64
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v betweenExclusiveValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be greater than %d and less than %d", v.min, v.max)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v betweenExclusiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 implements the validation logic.
+func (v betweenExclusiveValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value <= v.min || value >= v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
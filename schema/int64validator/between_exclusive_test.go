@@ -0,0 +1,139 @@
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBetweenExclusiveValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.Int64Request
+		expected *validator.Int64Response
+	}{
+		"null": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Null(),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"unknown": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Unknown(),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"inside": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(5),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"min": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(1),
+			},
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 1 and less than 10, got: 1",
+					),
+				},
+			},
+		},
+		"max": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(10),
+			},
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 1 and less than 10, got: 10",
+					),
+				},
+			},
+		},
+		"outside": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(-5),
+			},
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 1 and less than 10, got: -5",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.Int64Response{}
+
+			int64validator.BetweenExclusive(1, 10).ValidateInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBetweenExclusiveInvalidBounds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min int64
+		max int64
+	}{
+		"equal": {
+			min: 1,
+			max: 1,
+		},
+		"min-greater": {
+			min: 2,
+			max: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected panic, got none")
+				}
+			}()
+
+			int64validator.BetweenExclusive(testCase.min, testCase.max)
+		})
+	}
+}
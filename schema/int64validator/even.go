@@ -0,0 +1,46 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Even returns a validator which ensures that any configured int64 value
+// is an even number. Null and unknown values are skipped. Negative values are
+// classified the same as positive values, such as -3 being odd.
+func Even() validator.Int64 {
+	return evenValidator{}
+}
+
+// evenValidator implements the validator.
+type evenValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v evenValidator) Description(_ context.Context) string {
+	return "value must be even"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v evenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 implements the validation logic.
+func (v evenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value%2 != 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,104 @@
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEvenValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.Int64Request
+		expected *validator.Int64Response
+	}{
+		"null": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Null(),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"unknown": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Unknown(),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"0": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(0),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"2": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(2),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"3": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(3),
+			},
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be even, got: 3",
+					),
+				},
+			},
+		},
+		"negative-2": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(-2),
+			},
+			expected: &validator.Int64Response{},
+		},
+		"negative-3": {
+			request: validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(-3),
+			},
+			expected: &validator.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be even, got: -3",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.Int64Response{}
+
+			int64validator.Even().ValidateInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
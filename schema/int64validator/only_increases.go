@@ -0,0 +1,82 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// OnlyIncreases returns a validator which ensures that any planned int64
+// value of a resource attribute is greater than or equal to its prior state
+// value. The returned validator also implements validator.Update, which is
+// only called during resource update planning, so resource creation is always
+// valid. Null and unknown values are skipped.
+func OnlyIncreases() validator.Int64 {
+	return onlyIncreasesValidator{}
+}
+
+var _ validator.Update = onlyIncreasesValidator{}
+
+// onlyIncreasesValidator implements the validator.
+type onlyIncreasesValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v onlyIncreasesValidator) Description(_ context.Context) string {
+	return "value must not decrease from the prior value"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v onlyIncreasesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 performs no validation, since the configuration alone cannot
+// be compared with the prior state. The validation logic is implemented by
+// ValidateUpdate.
+func (v onlyIncreasesValidator) ValidateInt64(_ context.Context, _ validator.Int64Request, _ *validator.Int64Response) {
+}
+
+// ValidateUpdate implements the validation logic.
+func (v onlyIncreasesValidator) ValidateUpdate(ctx context.Context, req validator.UpdateRequest, resp *validator.UpdateResponse) {
+	planValue, ok := onlyIncreasesInt64(ctx, req.PlanValue)
+
+	if !ok {
+		return
+	}
+
+	stateValue, ok := onlyIncreasesInt64(ctx, req.StateValue)
+
+	if !ok {
+		return
+	}
+
+	if planValue.ValueInt64() < stateValue.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d, prior value: %d", req.Path, v.Description(ctx), planValue.ValueInt64(), stateValue.ValueInt64()),
+		)
+	}
+}
+
+// onlyIncreasesInt64 returns the value as a known basetypes.Int64Value. The
+// boolean is false if the value is null, unknown, or not an int64 value.
+func onlyIncreasesInt64(ctx context.Context, value attr.Value) (basetypes.Int64Value, bool) {
+	valuable, ok := value.(basetypes.Int64Valuable)
+
+	if !ok {
+		return basetypes.Int64Value{}, false
+	}
+
+	int64Value, diags := valuable.ToInt64Value(ctx)
+
+	if diags.HasError() || int64Value.IsNull() || int64Value.IsUnknown() {
+		return basetypes.Int64Value{}, false
+	}
+
+	return int64Value, true
+}
@@ -0,0 +1,95 @@
+package int64validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOnlyIncreasesValidateUpdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.UpdateRequest
+		expected *validator.UpdateResponse
+	}{
+		"increase": {
+			request: validator.UpdateRequest{
+				Path:       path.Root("test"),
+				PlanValue:  types.Int64Value(2),
+				StateValue: types.Int64Value(1),
+			},
+			expected: &validator.UpdateResponse{},
+		},
+		"equal": {
+			request: validator.UpdateRequest{
+				Path:       path.Root("test"),
+				PlanValue:  types.Int64Value(1),
+				StateValue: types.Int64Value(1),
+			},
+			expected: &validator.UpdateResponse{},
+		},
+		"decrease": {
+			request: validator.UpdateRequest{
+				Path:       path.Root("test"),
+				PlanValue:  types.Int64Value(1),
+				StateValue: types.Int64Value(2),
+			},
+			expected: &validator.UpdateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must not decrease from the prior value, got: 1, prior value: 2",
+					),
+				},
+			},
+		},
+		"plan-null": {
+			request: validator.UpdateRequest{
+				Path:       path.Root("test"),
+				PlanValue:  types.Int64Null(),
+				StateValue: types.Int64Value(2),
+			},
+			expected: &validator.UpdateResponse{},
+		},
+		"plan-unknown": {
+			request: validator.UpdateRequest{
+				Path:       path.Root("test"),
+				PlanValue:  types.Int64Unknown(),
+				StateValue: types.Int64Value(2),
+			},
+			expected: &validator.UpdateResponse{},
+		},
+		"state-null": {
+			request: validator.UpdateRequest{
+				Path:       path.Root("test"),
+				PlanValue:  types.Int64Value(1),
+				StateValue: types.Int64Null(),
+			},
+			expected: &validator.UpdateResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.UpdateResponse{}
+
+			int64validator.OnlyIncreases().(validator.Update).ValidateUpdate(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
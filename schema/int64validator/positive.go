@@ -0,0 +1,45 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Positive returns a validator which ensures that any configured int64 value
+// is greater than zero. Null and unknown values are skipped.
+func Positive() validator.Int64 {
+	return positiveValidator{}
+}
+
+// positiveValidator implements the validator.
+type positiveValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v positiveValidator) Description(_ context.Context) string {
+	return "value must be greater than 0"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v positiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 implements the validation logic.
+func (v positiveValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,100 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// TotalValueLengthAtMost returns a validator which ensures that the sum of
+// the UTF-8 character lengths of all element values of any configured list
+// of strings is at most the given maximum, such as an API limit on the total
+// length of all tag values. Null elements do not contribute to the total.
+// Null and unknown lists are skipped, as are lists with any unknown
+// element values, since the total cannot be determined.
+//
+// Use this validator with list attributes with a string element type,
+// including custom string types.
+func TotalValueLengthAtMost(max int) validator.List {
+	return totalValueLengthAtMostValidator{
+		max: max,
+	}
+}
+
+// totalValueLengthAtMostValidator implements the validator.
+type totalValueLengthAtMostValidator struct {
+	max int
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v totalValueLengthAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("total length of element values must be at most %d", v.max)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v totalValueLengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList implements the validation logic.
+func (v totalValueLengthAtMostValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var total int
+
+	for idx, element := range req.ConfigValue.Elements() {
+		elementValuable, ok := element.(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(idx),
+				"Invalid String Element Validator Value Type",
+				"An unexpected element value type was encountered while attempting to perform total string length validation. "+
+					"The element value type must implement the basetypes.StringValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Incoming Element Value Type: %T", element),
+			)
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if elementValue.IsUnknown() {
+			logging.FrameworkDebug(
+				ctx,
+				"Skipping total value length validation due to unknown element value",
+				map[string]interface{}{
+					logging.KeyAttributePath: req.Path.String(),
+				},
+			)
+
+			return
+		}
+
+		total += utf8.RuneCountInString(elementValue.ValueString())
+	}
+
+	if total <= v.max {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got total length: %d", req.Path, v.Description(ctx), total),
+	)
+}
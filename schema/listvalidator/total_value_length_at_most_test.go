@@ -0,0 +1,128 @@
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTotalValueLengthAtMostValidateList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.ListRequest
+		max      int
+		expected *validator.ListResponse
+	}{
+		"null": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListNull(types.StringType),
+			},
+			max:      1,
+			expected: &validator.ListResponse{},
+		},
+		"unknown": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListUnknown(types.StringType),
+			},
+			max:      1,
+			expected: &validator.ListResponse{},
+		},
+		"under-limit": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringNull(),
+						types.StringValue("xyz"),
+					},
+				),
+			},
+			max:      6,
+			expected: &validator.ListResponse{},
+		},
+		"over-limit": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringValue("xyz"),
+					},
+				),
+			},
+			max: 5,
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test total length of element values must be at most 5, got total length: 6",
+					),
+				},
+			},
+		},
+		"under-limit-multibyte": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("éé"),
+						types.StringValue("ü"),
+					},
+				),
+			},
+			max:      4,
+			expected: &validator.ListResponse{},
+		},
+		"unknown-element-skipped": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringUnknown(),
+					},
+				),
+			},
+			max:      1,
+			expected: &validator.ListResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.ListResponse{}
+
+			listvalidator.TotalValueLengthAtMost(testCase.max).ValidateList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// UniqueValues returns a validator which ensures that any configured list
+// does not contain duplicate element values, as determined by the element
+// value Equal method. An error diagnostic is raised for each duplicated
+// value which includes the indices of all occurrences. Null and unknown
+// lists are skipped, as are lists with any element value which is or
+// contains an unknown value since duplication cannot be determined.
+//
+// Use this validator with list attributes of any element type, including
+// object element types.
+func UniqueValues() validator.List {
+	return uniqueValuesValidator{}
+}
+
+// uniqueValuesValidator implements the validator.
+type uniqueValuesValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v uniqueValuesValidator) Description(_ context.Context) string {
+	return "element values must be unique"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v uniqueValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList implements the validation logic.
+func (v uniqueValuesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+
+	for idx, element := range elements {
+		tfValue, err := element.ToTerraformValue(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(idx),
+				"Invalid Unique Element Validator Value",
+				"An unexpected error was encountered while attempting to perform unique values validation. "+
+					"Please report this to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		if !tfValue.IsFullyKnown() {
+			logging.FrameworkDebug(
+				ctx,
+				"Skipping unique values validation due to unknown element value",
+				map[string]interface{}{
+					logging.KeyAttributePath: req.Path.String(),
+				},
+			)
+
+			return
+		}
+	}
+
+	// Track which element indices were already reported as a duplicate of
+	// an earlier element, so each duplicated value is only reported once.
+	reported := make(map[int]bool, len(elements))
+
+	for idx, element := range elements {
+		if reported[idx] {
+			continue
+		}
+
+		indices := []string{strconv.Itoa(idx)}
+
+		for otherIdx := idx + 1; otherIdx < len(elements); otherIdx++ {
+			if !element.Equal(elements[otherIdx]) {
+				continue
+			}
+
+			reported[otherIdx] = true
+			indices = append(indices, strconv.Itoa(otherIdx))
+		}
+
+		if len(indices) == 1 {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got duplicate value at indices %s: %s", req.Path, v.Description(ctx), strings.Join(indices, ", "), element),
+		)
+	}
+}
@@ -0,0 +1,205 @@
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUniqueValuesValidateList(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	testObject := func(name attr.Value) attr.Value {
+		return types.ObjectValueMust(
+			testObjectType.AttrTypes,
+			map[string]attr.Value{
+				"name": name,
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		request  validator.ListRequest
+		expected *validator.ListResponse
+	}{
+		"null": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListNull(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unknown": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListUnknown(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"string-unique": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("a"),
+						types.StringValue("b"),
+						types.StringNull(),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"string-duplicate": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("a"),
+						types.StringValue("b"),
+						types.StringValue("a"),
+						types.StringValue("c"),
+						types.StringValue("b"),
+						types.StringValue("a"),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test element values must be unique, got duplicate value at indices 0, 2, 5: "a"`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test element values must be unique, got duplicate value at indices 1, 4: "b"`,
+					),
+				},
+			},
+		},
+		"string-unknown-element": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("a"),
+						types.StringValue("a"),
+						types.StringUnknown(),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"int64-duplicate": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.Int64Type,
+					[]attr.Value{
+						types.Int64Value(1),
+						types.Int64Value(1),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test element values must be unique, got duplicate value at indices 0, 1: 1",
+					),
+				},
+			},
+		},
+		"object-unique": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					testObjectType,
+					[]attr.Value{
+						testObject(types.StringValue("a")),
+						testObject(types.StringValue("b")),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"object-duplicate": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					testObjectType,
+					[]attr.Value{
+						testObject(types.StringValue("a")),
+						testObject(types.StringValue("a")),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test element values must be unique, got duplicate value at indices 0, 1: {"name":"a"}`,
+					),
+				},
+			},
+		},
+		"object-unknown-attribute": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					testObjectType,
+					[]attr.Value{
+						testObject(types.StringValue("a")),
+						testObject(types.StringValue("a")),
+						testObject(types.StringUnknown()),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.ListResponse{}
+
+			listvalidator.UniqueValues().ValidateList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
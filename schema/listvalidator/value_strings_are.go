@@ -0,0 +1,110 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueStringsAre returns a validator which ensures that each element value
+// of any configured list of strings satisfies all of the given string
+// validators. Element diagnostics are reported at the path of each element.
+// Null and unknown lists, along with unknown element values, are skipped.
+//
+// Use this validator with list attributes with a string element type,
+// including custom string types.
+//
+// This validator walks the list elements itself, rather than relying on the
+// framework element iteration used for nested attributes, because a list
+// attribute with a string element type has no nested attribute definitions
+// for the framework to walk. Element paths are the same as the framework
+// uses for nested attribute elements, such as the AtListIndex path.
+func ValueStringsAre(elementValidators ...validator.String) validator.List {
+	return valueStringsAreValidator{
+		elementValidators: elementValidators,
+	}
+}
+
+// valueStringsAreValidator implements the validator.
+type valueStringsAreValidator struct {
+	elementValidators []validator.String
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v valueStringsAreValidator) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.elementValidators))
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v valueStringsAreValidator) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.elementValidators))
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateList implements the validation logic.
+func (v valueStringsAreValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for idx, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtListIndex(idx)
+
+		elementValuable, ok := element.(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid String Element Validator Value Type",
+				"An unexpected element value type was encountered while attempting to perform string element validation. "+
+					"The element value type must implement the basetypes.StringValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Incoming Element Value Type: %T", element),
+			)
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if elementValue.IsUnknown() {
+			continue
+		}
+
+		elementReq := validator.StringRequest{
+			Path:           elementPath,
+			PathExpression: req.PathExpression.AtListIndex(idx),
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.elementValidators {
+			elementResp := &validator.StringResponse{}
+
+			elementValidator.ValidateString(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueStringsAreValidateList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.ListRequest
+		expected *validator.ListResponse
+	}{
+		"null": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListNull(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unknown": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListUnknown(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"valid": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringUnknown(),
+						types.StringNull(),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"invalid": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringValue("bad"),
+						types.StringUnknown(),
+						types.StringNull(),
+						types.StringValue("xyz"),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(1),
+						"Invalid Attribute Value",
+						`Attribute test[1] value must start with "a", got: "bad"`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(4),
+						"Invalid Attribute Value",
+						`Attribute test[4] value must start with "a", got: "xyz"`,
+					),
+				},
+			},
+		},
+		"invalid-element-type": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.Int64Type,
+					[]attr.Value{
+						types.Int64Value(1),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0),
+						"Invalid String Element Validator Value Type",
+						"An unexpected element value type was encountered while attempting to perform string element validation. "+
+							"The element value type must implement the basetypes.StringValuable interface. "+
+							"Please report this to the provider developers.\n\n"+
+							"Incoming Element Value Type: basetypes.Int64Value",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.ListResponse{}
+
+			listvalidator.ValueStringsAre(stringvalidator.HasPrefix("a")).ValidateList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
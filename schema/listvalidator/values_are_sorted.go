@@ -0,0 +1,193 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValuesAreSorted returns a validator which ensures that the element values
+// of any configured list are in ascending order. Strings are compared by
+// lexical byte order and numbers are compared by value. Equal adjacent
+// values are permitted. Null and unknown lists are skipped, as are lists
+// with any unknown element value since ordering is indeterminate. Null
+// element values are not compared.
+//
+// Use this validator with list attributes with a string, int64, float64, or
+// number element type, including custom types of those.
+func ValuesAreSorted() validator.List {
+	return valuesAreSortedValidator{}
+}
+
+// ValuesAreSortedDescending returns a validator which ensures that the
+// element values of any configured list are in descending order. It
+// otherwise behaves the same as ValuesAreSorted.
+func ValuesAreSortedDescending() validator.List {
+	return valuesAreSortedValidator{
+		descending: true,
+	}
+}
+
+// valuesAreSortedValidator implements the validator.
+type valuesAreSortedValidator struct {
+	descending bool
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v valuesAreSortedValidator) Description(_ context.Context) string {
+	if v.descending {
+		return "element values must be sorted in descending order"
+	}
+
+	return "element values must be sorted in ascending order"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v valuesAreSortedValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList implements the validation logic.
+func (v valuesAreSortedValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	sortables := make([]*sortableElement, len(elements))
+
+	for idx, element := range elements {
+		sortable, diags := newSortableElement(ctx, req.Path.AtListIndex(idx), element)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if element.IsUnknown() {
+			logging.FrameworkDebug(
+				ctx,
+				"Skipping sorted values validation due to unknown element value",
+				map[string]interface{}{
+					logging.KeyAttributePath: req.Path.String(),
+				},
+			)
+
+			return
+		}
+
+		sortables[idx] = sortable
+	}
+
+	var previous *sortableElement
+
+	for idx, sortable := range sortables {
+		if sortable == nil {
+			continue
+		}
+
+		if previous != nil {
+			comparison := previous.compare(sortable)
+
+			if (!v.descending && comparison > 0) || (v.descending && comparison < 0) {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid Attribute Value",
+					fmt.Sprintf("Attribute %s %s, got out of order element at index %d: %s", req.Path, v.Description(ctx), idx, elements[idx]),
+				)
+
+				return
+			}
+		}
+
+		previous = sortable
+	}
+}
+
+// sortableElement is a comparable representation of a list element value.
+// Numbers are compared by value, otherwise strings are compared lexically.
+type sortableElement struct {
+	number *big.Float
+	str    string
+}
+
+// compare returns -1 if the element is less than the other element, 0 if
+// they are equal, and 1 if the element is greater than the other element.
+func (e *sortableElement) compare(o *sortableElement) int {
+	if e.number != nil && o.number != nil {
+		return e.number.Cmp(o.number)
+	}
+
+	return strings.Compare(e.str, o.str)
+}
+
+// newSortableElement returns the sortableElement of a list element value, or
+// nil if the value is null or unknown. An error diagnostic is returned if the
+// element value type cannot be compared.
+func newSortableElement(ctx context.Context, elementPath path.Path, element attr.Value) (*sortableElement, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch elementValuable := element.(type) {
+	case basetypes.StringValuable:
+		elementValue, elementDiags := elementValuable.ToStringValue(ctx)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() || elementValue.IsNull() || elementValue.IsUnknown() {
+			return nil, diags
+		}
+
+		return &sortableElement{str: elementValue.ValueString()}, diags
+	case basetypes.Int64Valuable:
+		elementValue, elementDiags := elementValuable.ToInt64Value(ctx)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() || elementValue.IsNull() || elementValue.IsUnknown() {
+			return nil, diags
+		}
+
+		return &sortableElement{number: new(big.Float).SetInt64(elementValue.ValueInt64())}, diags
+	case basetypes.Float64Valuable:
+		elementValue, elementDiags := elementValuable.ToFloat64Value(ctx)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() || elementValue.IsNull() || elementValue.IsUnknown() {
+			return nil, diags
+		}
+
+		return &sortableElement{number: big.NewFloat(elementValue.ValueFloat64())}, diags
+	case basetypes.NumberValuable:
+		elementValue, elementDiags := elementValuable.ToNumberValue(ctx)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() || elementValue.IsNull() || elementValue.IsUnknown() {
+			return nil, diags
+		}
+
+		return &sortableElement{number: elementValue.ValueBigFloat()}, diags
+	}
+
+	diags.AddAttributeError(
+		elementPath,
+		"Invalid Sorted Element Validator Value Type",
+		"An unexpected element value type was encountered while attempting to perform sorted values validation. "+
+			"The element value type must implement the basetypes.StringValuable, basetypes.Int64Valuable, basetypes.Float64Valuable, or basetypes.NumberValuable interface. "+
+			"Please report this to the provider developers.\n\n"+
+			fmt.Sprintf("Incoming Element Value Type: %T", element),
+	)
+
+	return nil, diags
+}
@@ -0,0 +1,303 @@
+package listvalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValuesAreSortedValidateList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request    validator.ListRequest
+		descending bool
+		expected   *validator.ListResponse
+	}{
+		"null": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListNull(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"unknown": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.ListUnknown(types.StringType),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"single-element": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("b"),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"string-sorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("a"),
+						types.StringValue("b"),
+						types.StringNull(),
+						types.StringValue("b"),
+						types.StringValue("c"),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"string-unsorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("a"),
+						types.StringValue("c"),
+						types.StringValue("b"),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test element values must be sorted in ascending order, got out of order element at index 2: "b"`,
+					),
+				},
+			},
+		},
+		"string-unknown-element": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("c"),
+						types.StringUnknown(),
+						types.StringValue("a"),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"string-descending-sorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("c"),
+						types.StringValue("b"),
+						types.StringValue("a"),
+					},
+				),
+			},
+			descending: true,
+			expected:   &validator.ListResponse{},
+		},
+		"string-descending-unsorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("a"),
+						types.StringValue("b"),
+					},
+				),
+			},
+			descending: true,
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test element values must be sorted in descending order, got out of order element at index 1: "b"`,
+					),
+				},
+			},
+		},
+		"int64-sorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.Int64Type,
+					[]attr.Value{
+						types.Int64Value(2),
+						types.Int64Value(10),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"int64-unsorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.Int64Type,
+					[]attr.Value{
+						types.Int64Value(10),
+						types.Int64Value(2),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test element values must be sorted in ascending order, got out of order element at index 1: 2",
+					),
+				},
+			},
+		},
+		"float64-sorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.Float64Type,
+					[]attr.Value{
+						types.Float64Value(-1.5),
+						types.Float64Value(0.5),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"float64-unsorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.Float64Type,
+					[]attr.Value{
+						types.Float64Value(0.5),
+						types.Float64Value(-1.5),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test element values must be sorted in ascending order, got out of order element at index 1: -1.500000",
+					),
+				},
+			},
+		},
+		"number-sorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.NumberType,
+					[]attr.Value{
+						types.NumberValue(big.NewFloat(1)),
+						types.NumberValue(big.NewFloat(1.5)),
+					},
+				),
+			},
+			expected: &validator.ListResponse{},
+		},
+		"number-unsorted": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.NumberType,
+					[]attr.Value{
+						types.NumberValue(big.NewFloat(1.5)),
+						types.NumberValue(big.NewFloat(1)),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test element values must be sorted in ascending order, got out of order element at index 1: 1",
+					),
+				},
+			},
+		},
+		"invalid-element-type": {
+			request: validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.ListValueMust(
+					types.BoolType,
+					[]attr.Value{
+						types.BoolValue(true),
+					},
+				),
+			},
+			expected: &validator.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0),
+						"Invalid Sorted Element Validator Value Type",
+						"An unexpected element value type was encountered while attempting to perform sorted values validation. "+
+							"The element value type must implement the basetypes.StringValuable, basetypes.Int64Valuable, basetypes.Float64Valuable, or basetypes.NumberValuable interface. "+
+							"Please report this to the provider developers.\n\n"+
+							"Incoming Element Value Type: basetypes.BoolValue",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.ListResponse{}
+
+			v := listvalidator.ValuesAreSorted()
+
+			if testCase.descending {
+				v = listvalidator.ValuesAreSortedDescending()
+			}
+
+			v.ValidateList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
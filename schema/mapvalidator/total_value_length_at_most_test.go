@@ -0,0 +1,128 @@
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTotalValueLengthAtMostValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.MapRequest
+		max      int
+		expected *validator.MapResponse
+	}{
+		"null": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.MapNull(types.StringType),
+			},
+			max:      1,
+			expected: &validator.MapResponse{},
+		},
+		"unknown": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.MapUnknown(types.StringType),
+			},
+			max:      1,
+			expected: &validator.MapResponse{},
+		},
+		"under-limit": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"key1": types.StringValue("abc"),
+						"key2": types.StringNull(),
+						"key3": types.StringValue("xyz"),
+					},
+				),
+			},
+			max:      6,
+			expected: &validator.MapResponse{},
+		},
+		"over-limit": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"key1": types.StringValue("abc"),
+						"key2": types.StringValue("xyz"),
+					},
+				),
+			},
+			max: 5,
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test total length of element values must be at most 5, got total length: 6",
+					),
+				},
+			},
+		},
+		"under-limit-multibyte": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"key1": types.StringValue("éé"),
+						"key2": types.StringValue("ü"),
+					},
+				),
+			},
+			max:      4,
+			expected: &validator.MapResponse{},
+		},
+		"unknown-element-skipped": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"key1": types.StringValue("abc"),
+						"key2": types.StringUnknown(),
+					},
+				),
+			},
+			max:      1,
+			expected: &validator.MapResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.MapResponse{}
+
+			mapvalidator.TotalValueLengthAtMost(testCase.max).ValidateMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueAttributeMatchesKey returns a validator which ensures that the string
+// value of the given attribute of each element of any configured map of
+// objects equals the map key of the element, such as a name attribute which
+// must match the key it is stored under. Null and unknown maps, elements, and
+// attribute values are skipped.
+//
+// Use this validator with map nested attributes or map attributes with an
+// object element type, where the named attribute has a string type,
+// including custom object and string types.
+func ValueAttributeMatchesKey(attributeName string) validator.Map {
+	return valueAttributeMatchesKeyValidator{
+		attributeName: attributeName,
+	}
+}
+
+// valueAttributeMatchesKeyValidator implements the validator.
+type valueAttributeMatchesKeyValidator struct {
+	attributeName string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v valueAttributeMatchesKeyValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("element %s attribute value must match the element map key", v.attributeName)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v valueAttributeMatchesKeyValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("element `%s` attribute value must match the element map key", v.attributeName)
+}
+
+// ValidateMap implements the validation logic.
+func (v valueAttributeMatchesKeyValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	// Sort keys for consistent diagnostics ordering.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		element := elements[key]
+		elementPath := req.Path.AtMapKey(key)
+
+		elementValuable, ok := element.(basetypes.ObjectValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid Object Element Validator Value Type",
+				"An unexpected element value type was encountered while attempting to perform map key validation. "+
+					"The element value type must implement the basetypes.ObjectValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Incoming Element Value Type: %T", element),
+			)
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToObjectValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if elementValue.IsNull() || elementValue.IsUnknown() {
+			continue
+		}
+
+		attributePath := elementPath.AtName(v.attributeName)
+		attribute, ok := elementValue.Attributes()[v.attributeName]
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Invalid Validator Attribute Name",
+				"An unexpected attribute name was encountered while attempting to perform map key validation. "+
+					"The element object type must contain the attribute name given to the validator. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Attribute Name: %s", v.attributeName),
+			)
+
+			return
+		}
+
+		attributeValuable, ok := attribute.(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Invalid String Attribute Validator Value Type",
+				"An unexpected attribute value type was encountered while attempting to perform map key validation. "+
+					"The attribute value type must implement the basetypes.StringValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Incoming Attribute Value Type: %T", attribute),
+			)
+
+			return
+		}
+
+		attributeValue, diags := attributeValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if attributeValue.IsNull() || attributeValue.IsUnknown() {
+			continue
+		}
+
+		if attributeValue.ValueString() == key {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s value must match the element map key %q, got: %q", attributePath, key, attributeValue.ValueString()),
+		)
+	}
+}
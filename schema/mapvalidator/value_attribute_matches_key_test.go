@@ -0,0 +1,219 @@
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueAttributeMatchesKeyValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	testObject := func(name types.String) attr.Value {
+		return types.ObjectValueMust(
+			testObjectType.AttrTypes,
+			map[string]attr.Value{
+				"name": name,
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		request       validator.MapRequest
+		attributeName string
+		expected      *validator.MapResponse
+	}{
+		"null": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.MapNull(testObjectType),
+			},
+			attributeName: "name",
+			expected:      &validator.MapResponse{},
+		},
+		"unknown": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.MapUnknown(testObjectType),
+			},
+			attributeName: "name",
+			expected:      &validator.MapResponse{},
+		},
+		"matching": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					testObjectType,
+					map[string]attr.Value{
+						"first":  testObject(types.StringValue("first")),
+						"second": testObject(types.StringValue("second")),
+					},
+				),
+			},
+			attributeName: "name",
+			expected:      &validator.MapResponse{},
+		},
+		"mismatching": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					testObjectType,
+					map[string]attr.Value{
+						"first":  testObject(types.StringValue("first")),
+						"second": testObject(types.StringValue("other")),
+						"third":  testObject(types.StringValue("another")),
+					},
+				),
+			},
+			attributeName: "name",
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("second").AtName("name"),
+						"Invalid Attribute Value",
+						`Attribute test["second"].name value must match the element map key "second", got: "other"`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("third").AtName("name"),
+						"Invalid Attribute Value",
+						`Attribute test["third"].name value must match the element map key "third", got: "another"`,
+					),
+				},
+			},
+		},
+		"element-unknown": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					testObjectType,
+					map[string]attr.Value{
+						"first": types.ObjectUnknown(testObjectType.AttrTypes),
+					},
+				),
+			},
+			attributeName: "name",
+			expected:      &validator.MapResponse{},
+		},
+		"attribute-null": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					testObjectType,
+					map[string]attr.Value{
+						"first": testObject(types.StringNull()),
+					},
+				),
+			},
+			attributeName: "name",
+			expected:      &validator.MapResponse{},
+		},
+		"attribute-unknown": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					testObjectType,
+					map[string]attr.Value{
+						"first":  testObject(types.StringUnknown()),
+						"second": testObject(types.StringValue("other")),
+					},
+				),
+			},
+			attributeName: "name",
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("second").AtName("name"),
+						"Invalid Attribute Value",
+						`Attribute test["second"].name value must match the element map key "second", got: "other"`,
+					),
+				},
+			},
+		},
+		"attribute-missing": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					testObjectType,
+					map[string]attr.Value{
+						"first": testObject(types.StringValue("first")),
+					},
+				),
+			},
+			attributeName: "id",
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("first").AtName("id"),
+						"Invalid Validator Attribute Name",
+						"An unexpected attribute name was encountered while attempting to perform map key validation. "+
+							"The element object type must contain the attribute name given to the validator. "+
+							"Please report this to the provider developers.\n\n"+
+							"Attribute Name: id",
+					),
+				},
+			},
+		},
+		"element-not-object": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"first": types.StringValue("first"),
+					},
+				),
+			},
+			attributeName: "name",
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("first"),
+						"Invalid Object Element Validator Value Type",
+						"An unexpected element value type was encountered while attempting to perform map key validation. "+
+							"The element value type must implement the basetypes.ObjectValuable interface. "+
+							"Please report this to the provider developers.\n\n"+
+							"Incoming Element Value Type: basetypes.StringValue",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.MapResponse{}
+
+			mapvalidator.ValueAttributeMatchesKey(testCase.attributeName).ValidateMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueStringsAre returns a validator which ensures that each element value
+// of any configured map of strings satisfies all of the given string
+// validators. Element diagnostics are reported at the path of each element.
+// Null and unknown maps, along with unknown element values, are skipped.
+//
+// Use this validator with map attributes with a string element type,
+// including custom string types.
+//
+// Map attributes with a string element type have no nested attribute
+// definitions for the framework element iteration to walk, so this
+// validator iterates the map elements itself. Diagnostics use the same
+// AtMapKey element paths as nested attribute map elements.
+func ValueStringsAre(elementValidators ...validator.String) validator.Map {
+	return valueStringsAreValidator{
+		elementValidators: elementValidators,
+	}
+}
+
+// valueStringsAreValidator implements the validator.
+type valueStringsAreValidator struct {
+	elementValidators []validator.String
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v valueStringsAreValidator) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.elementValidators))
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v valueStringsAreValidator) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.elementValidators))
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateMap implements the validation logic.
+func (v valueStringsAreValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+
+	for key := range elements {
+		keys = append(keys, key)
+	}
+
+	// Sort keys for consistent diagnostics ordering.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		element := elements[key]
+		elementPath := req.Path.AtMapKey(key)
+
+		elementValuable, ok := element.(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid String Element Validator Value Type",
+				"An unexpected element value type was encountered while attempting to perform string element validation. "+
+					"The element value type must implement the basetypes.StringValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Incoming Element Value Type: %T", element),
+			)
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if elementValue.IsUnknown() {
+			continue
+		}
+
+		elementReq := validator.StringRequest{
+			Path:           elementPath,
+			PathExpression: req.PathExpression.AtMapKey(key),
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.elementValidators {
+			elementResp := &validator.StringResponse{}
+
+			elementValidator.ValidateString(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
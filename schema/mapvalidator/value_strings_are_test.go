@@ -0,0 +1,126 @@
+package mapvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueStringsAreValidateMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.MapRequest
+		expected *validator.MapResponse
+	}{
+		"null": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.MapNull(types.StringType),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"unknown": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.MapUnknown(types.StringType),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"valid": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"one":   types.StringValue("abc"),
+						"three": types.StringUnknown(),
+						"four":  types.StringNull(),
+					},
+				),
+			},
+			expected: &validator.MapResponse{},
+		},
+		"invalid": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"one":   types.StringValue("abc"),
+						"two":   types.StringValue("bad"),
+						"three": types.StringUnknown(),
+						"four":  types.StringNull(),
+						"five":  types.StringValue("xyz"),
+					},
+				),
+			},
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("five"),
+						"Invalid Attribute Value",
+						`Attribute test["five"] value must start with "a", got: "xyz"`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("two"),
+						"Invalid Attribute Value",
+						`Attribute test["two"] value must start with "a", got: "bad"`,
+					),
+				},
+			},
+		},
+		"invalid-element-type": {
+			request: validator.MapRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.MapValueMust(
+					types.Int64Type,
+					map[string]attr.Value{
+						"one": types.Int64Value(1),
+					},
+				),
+			},
+			expected: &validator.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtMapKey("one"),
+						"Invalid String Element Validator Value Type",
+						"An unexpected element value type was encountered while attempting to perform string element validation. "+
+							"The element value type must implement the basetypes.StringValuable interface. "+
+							"Please report this to the provider developers.\n\n"+
+							"Incoming Element Value Type: basetypes.Int64Value",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.MapResponse{}
+
+			mapvalidator.ValueStringsAre(stringvalidator.HasPrefix("a")).ValidateMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// BetweenExclusive returns a validator which ensures that any configured
+// number value is strictly greater than the given minimum and strictly less
+// than the given maximum. Null and unknown values are skipped.
+//
+// BetweenExclusive panics if min or max is nil or if min is not less than
+// max, since no value could be valid.
+func BetweenExclusive(min, max *big.Float) validator.Number {
+	if min == nil || max == nil || min.Cmp(max) >= 0 {
+		panic(fmt.Sprintf("invalid BetweenExclusive bounds: min (%v) must be less than max (%v)", min, max))
+	}
+
+	return betweenExclusiveValidator{
+		max: max,
+		min: min,
+	}
+}
+
+// betweenExclusiveValidator implements the validator.
+type betweenExclusiveValidator struct {
+	max *big.Float
+	min *big.Float
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v betweenExclusiveValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be greater than %v and less than %v", v.min, v.max)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v betweenExclusiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateNumber implements the validation logic.
+func (v betweenExclusiveValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+
+	if value.Cmp(v.min) <= 0 || value.Cmp(v.max) >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %v", req.Path, v.Description(ctx), value),
+		)
+	}
+}
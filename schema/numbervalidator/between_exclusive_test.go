@@ -0,0 +1,144 @@
+package numbervalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/numbervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBetweenExclusiveValidateNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.NumberRequest
+		expected *validator.NumberResponse
+	}{
+		"null": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberNull(),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"unknown": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberUnknown(),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"inside": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(0.5)),
+			},
+			expected: &validator.NumberResponse{},
+		},
+		"min": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(0)),
+			},
+			expected: &validator.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: 0",
+					),
+				},
+			},
+		},
+		"max": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(1)),
+			},
+			expected: &validator.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: 1",
+					),
+				},
+			},
+		},
+		"outside": {
+			request: validator.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(1.5)),
+			},
+			expected: &validator.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must be greater than 0 and less than 1, got: 1.5",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.NumberResponse{}
+
+			numbervalidator.BetweenExclusive(big.NewFloat(0), big.NewFloat(1)).ValidateNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBetweenExclusiveInvalidBounds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min *big.Float
+		max *big.Float
+	}{
+		"equal": {
+			min: big.NewFloat(1),
+			max: big.NewFloat(1),
+		},
+		"min-greater": {
+			min: big.NewFloat(2),
+			max: big.NewFloat(1),
+		},
+		"nil": {
+			min: nil,
+			max: big.NewFloat(1),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected panic, got none")
+				}
+			}()
+
+			numbervalidator.BetweenExclusive(testCase.min, testCase.max)
+		})
+	}
+}
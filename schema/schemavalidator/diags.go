@@ -0,0 +1,25 @@
+package schemavalidator
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// invalidWrappedValidatorDiag returns an error diagnostic for a wrapped
+// validator which does not implement the validator interface of the
+// attribute type. The validation describes the kind of validation being
+// performed by the wrapping validator.
+func invalidWrappedValidatorDiag(validation string, attributePath path.Path, wrapped validator.Describer, validatorType string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Validator Implementation",
+		fmt.Sprintf("An unexpected validator implementation was encountered while attempting to perform %s validation. ", validation)+
+			fmt.Sprintf("The wrapped validator must implement the %s interface. ", validatorType)+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Path: %s\n", attributePath)+
+			fmt.Sprintf("Validator Type: %T", wrapped),
+	)
+}
@@ -0,0 +1,5 @@
+// Package schemavalidator provides validators which are independent of the
+// attribute type, such as validators which compare an attribute value with
+// other attributes in the schema, which are referenced via path expressions,
+// or which control when wrapped validators are called.
+package schemavalidator
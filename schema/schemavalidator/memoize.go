@@ -0,0 +1,248 @@
+package schemavalidator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.Memoizable = MemoizeValidator{}
+	_ validator.Bool       = MemoizeValidator{}
+	_ validator.Float64    = MemoizeValidator{}
+	_ validator.Int64      = MemoizeValidator{}
+	_ validator.List       = MemoizeValidator{}
+	_ validator.Map        = MemoizeValidator{}
+	_ validator.Number     = MemoizeValidator{}
+	_ validator.Object     = MemoizeValidator{}
+	_ validator.Set        = MemoizeValidator{}
+	_ validator.String     = MemoizeValidator{}
+)
+
+// Memoize returns a validator which enables the framework to skip calling the
+// wrapped validators for a configured value equal to a value for which they
+// previously returned no diagnostics during the same configuration
+// validation, such as an expensive validator of an underlying attribute of
+// many nested objects in a large set or list. Refer to the
+// validator.Memoizable interface for details.
+//
+// Only wrap validators whose result depends solely on the configured value,
+// not on other configuration values.
+//
+// Each wrapped validator must implement the validator interface for the type
+// of the attribute being validated, such as validator.String for a
+// StringAttribute.
+//
+// The returned validator implements all validator interfaces, so it can be
+// used with any attribute type.
+func Memoize(wrapped ...validator.Describer) MemoizeValidator {
+	return MemoizeValidator{
+		wrapped: wrapped,
+	}
+}
+
+// MemoizeValidator is the validator returned by Memoize.
+type MemoizeValidator struct {
+	wrapped []validator.Describer
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v MemoizeValidator) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.wrapped))
+
+	for _, w := range v.wrapped {
+		descriptions = append(descriptions, w.Description(ctx))
+	}
+
+	return strings.Join(descriptions, " and ")
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v MemoizeValidator) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.wrapped))
+
+	for _, w := range v.wrapped {
+		descriptions = append(descriptions, w.MarkdownDescription(ctx))
+	}
+
+	return strings.Join(descriptions, " and ")
+}
+
+// ValidateMemoizable returns true, so the framework can skip calling the
+// validator for equal values.
+func (v MemoizeValidator) ValidateMemoizable() bool {
+	return true
+}
+
+// ValidateBool implements the validation logic for bool attributes.
+func (v MemoizeValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Bool)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.Bool"))
+
+			continue
+		}
+
+		wrappedResp := &validator.BoolResponse{}
+
+		wrapped.ValidateBool(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateFloat64 implements the validation logic for float64 attributes.
+func (v MemoizeValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Float64)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.Float64"))
+
+			continue
+		}
+
+		wrappedResp := &validator.Float64Response{}
+
+		wrapped.ValidateFloat64(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateInt64 implements the validation logic for int64 attributes.
+func (v MemoizeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Int64)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.Int64"))
+
+			continue
+		}
+
+		wrappedResp := &validator.Int64Response{}
+
+		wrapped.ValidateInt64(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateList implements the validation logic for list attributes.
+func (v MemoizeValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.List)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.List"))
+
+			continue
+		}
+
+		wrappedResp := &validator.ListResponse{}
+
+		wrapped.ValidateList(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateMap implements the validation logic for map attributes.
+func (v MemoizeValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Map)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.Map"))
+
+			continue
+		}
+
+		wrappedResp := &validator.MapResponse{}
+
+		wrapped.ValidateMap(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateNumber implements the validation logic for number attributes.
+func (v MemoizeValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Number)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.Number"))
+
+			continue
+		}
+
+		wrappedResp := &validator.NumberResponse{}
+
+		wrapped.ValidateNumber(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateObject implements the validation logic for object attributes.
+func (v MemoizeValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Object)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.Object"))
+
+			continue
+		}
+
+		wrappedResp := &validator.ObjectResponse{}
+
+		wrapped.ValidateObject(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateSet implements the validation logic for set attributes.
+func (v MemoizeValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Set)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.Set"))
+
+			continue
+		}
+
+		wrappedResp := &validator.SetResponse{}
+
+		wrapped.ValidateSet(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateString implements the validation logic for string attributes.
+func (v MemoizeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.String)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("memoized", req.Path, w, "validator.String"))
+
+			continue
+		}
+
+		wrappedResp := &validator.StringResponse{}
+
+		wrapped.ValidateString(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
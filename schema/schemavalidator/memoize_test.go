@@ -0,0 +1,111 @@
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMemoizeValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		wrapped  []validator.Describer
+		expected *validator.StringResponse
+	}{
+		"valid": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue("https://example.com"),
+			},
+			wrapped: []validator.Describer{
+				stringvalidator.HasPrefix("https://"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"invalid": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue("http://example.com"),
+			},
+			wrapped: []validator.Describer{
+				stringvalidator.HasPrefix("https://"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must start with "https://", got: "http://example.com"`,
+					),
+				},
+			},
+		},
+		"wrapped-invalid-type": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue("https://example.com"),
+			},
+			wrapped: []validator.Describer{
+				int64validator.Positive(),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Validator Implementation",
+						"An unexpected validator implementation was encountered while attempting to perform memoized validation. "+
+							"The wrapped validator must implement the validator.String interface. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Path: test\n"+
+							"Validator Type: int64validator.positiveValidator",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			schemavalidator.Memoize(testCase.wrapped...).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoizeValidateMemoizable(t *testing.T) {
+	t.Parallel()
+
+	var v validator.Describer = schemavalidator.Memoize(stringvalidator.HasPrefix("https://"))
+
+	memoizableValidator, ok := v.(validator.Memoizable)
+
+	if !ok {
+		t.Fatal("expected validator to implement validator.Memoizable")
+	}
+
+	if !memoizableValidator.ValidateMemoizable() {
+		t.Error("expected ValidateMemoizable to return true")
+	}
+}
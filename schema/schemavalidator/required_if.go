@@ -0,0 +1,402 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ validator.Bool    = RequiredIfValidator{}
+	_ validator.Float64 = RequiredIfValidator{}
+	_ validator.Int64   = RequiredIfValidator{}
+	_ validator.List    = RequiredIfValidator{}
+	_ validator.Map     = RequiredIfValidator{}
+	_ validator.Number  = RequiredIfValidator{}
+	_ validator.Object  = RequiredIfValidator{}
+	_ validator.Set     = RequiredIfValidator{}
+	_ validator.String  = RequiredIfValidator{}
+)
+
+// RequiredIf returns a validator which ensures that the attribute is
+// configured when the boolean attribute at the given flag path expression is
+// true, such as a group of settings which are gated by an "enabled" toggle.
+// The flag expression is resolved from the root of the configuration. If the
+// flag is null, unknown, or false, the attribute is not required and
+// validation is skipped. If the expression matches multiple attributes, the
+// attribute is required when any of them are true.
+//
+// The wrapped validators are only called when the flag is true and the
+// attribute is configured. Each wrapped validator must implement the
+// validator interface for the type of the attribute being validated, such as
+// validator.String for a StringAttribute.
+//
+// The returned validator implements all validator interfaces, so it can be
+// used with any attribute type.
+func RequiredIf(flagExpression path.Expression, wrapped ...validator.Describer) RequiredIfValidator {
+	return RequiredIfValidator{
+		flagExpression: flagExpression,
+		wrapped:        wrapped,
+	}
+}
+
+// RequiredIfValidator is the validator returned by RequiredIf.
+type RequiredIfValidator struct {
+	flagExpression path.Expression
+	wrapped        []validator.Describer
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v RequiredIfValidator) Description(ctx context.Context) string {
+	description := fmt.Sprintf("value must be configured when %s is true", v.flagExpression)
+
+	if len(v.wrapped) == 0 {
+		return description
+	}
+
+	descriptions := make([]string, 0, len(v.wrapped))
+
+	for _, w := range v.wrapped {
+		descriptions = append(descriptions, w.Description(ctx))
+	}
+
+	return description + ", then " + strings.Join(descriptions, " and ")
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v RequiredIfValidator) MarkdownDescription(ctx context.Context) string {
+	description := fmt.Sprintf("value must be configured when `%s` is true", v.flagExpression)
+
+	if len(v.wrapped) == 0 {
+		return description
+	}
+
+	descriptions := make([]string, 0, len(v.wrapped))
+
+	for _, w := range v.wrapped {
+		descriptions = append(descriptions, w.MarkdownDescription(ctx))
+	}
+
+	return description + ", then " + strings.Join(descriptions, " and ")
+}
+
+// validate returns whether the wrapped validators should be called, along
+// with any diagnostics from checking the flag and attribute value.
+func (v RequiredIfValidator) validate(ctx context.Context, attributePath path.Path, config tfsdk.Config, value attr.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matchedPaths, matchDiags := config.PathMatches(ctx, v.flagExpression)
+
+	diags.Append(matchDiags...)
+
+	if matchDiags.HasError() {
+		return false, diags
+	}
+
+	for _, matchedPath := range matchedPaths {
+		var matchedValue attr.Value
+
+		getDiags := config.GetAttribute(ctx, matchedPath, &matchedValue)
+
+		diags.Append(getDiags...)
+
+		if getDiags.HasError() {
+			continue
+		}
+
+		// A null or unknown value may also be a parent path of the
+		// expression, so these must be checked before the value type.
+		if matchedValue.IsNull() || matchedValue.IsUnknown() {
+			continue
+		}
+
+		boolValuable, ok := matchedValue.(basetypes.BoolValuable)
+
+		if !ok {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid Validator Path Expression",
+				"An unexpected value type was encountered while attempting to perform conditional required validation. "+
+					"The path expression must reference an attribute with a value type that implements the basetypes.BoolValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Path: %s\n", matchedPath)+
+					fmt.Sprintf("Value Type: %T", matchedValue),
+			)
+
+			continue
+		}
+
+		boolValue, boolDiags := boolValuable.ToBoolValue(ctx)
+
+		diags.Append(boolDiags...)
+
+		if boolDiags.HasError() || !boolValue.ValueBool() {
+			continue
+		}
+
+		if value.IsNull() {
+			diags.AddAttributeError(
+				attributePath,
+				"Missing Attribute Configuration",
+				fmt.Sprintf("Attribute %s must be configured when %s is true", attributePath, matchedPath),
+			)
+
+			return false, diags
+		}
+
+		return !diags.HasError(), diags
+	}
+
+	return false, diags
+}
+
+// ValidateBool implements the validation logic for bool attributes.
+func (v RequiredIfValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Bool)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.Bool"))
+
+			continue
+		}
+
+		wrappedResp := &validator.BoolResponse{}
+
+		wrapped.ValidateBool(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateFloat64 implements the validation logic for float64 attributes.
+func (v RequiredIfValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Float64)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.Float64"))
+
+			continue
+		}
+
+		wrappedResp := &validator.Float64Response{}
+
+		wrapped.ValidateFloat64(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateInt64 implements the validation logic for int64 attributes.
+func (v RequiredIfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Int64)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.Int64"))
+
+			continue
+		}
+
+		wrappedResp := &validator.Int64Response{}
+
+		wrapped.ValidateInt64(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateList implements the validation logic for list attributes.
+func (v RequiredIfValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.List)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.List"))
+
+			continue
+		}
+
+		wrappedResp := &validator.ListResponse{}
+
+		wrapped.ValidateList(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateMap implements the validation logic for map attributes.
+func (v RequiredIfValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Map)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.Map"))
+
+			continue
+		}
+
+		wrappedResp := &validator.MapResponse{}
+
+		wrapped.ValidateMap(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateNumber implements the validation logic for number attributes.
+func (v RequiredIfValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Number)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.Number"))
+
+			continue
+		}
+
+		wrappedResp := &validator.NumberResponse{}
+
+		wrapped.ValidateNumber(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateObject implements the validation logic for object attributes.
+func (v RequiredIfValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Object)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.Object"))
+
+			continue
+		}
+
+		wrappedResp := &validator.ObjectResponse{}
+
+		wrapped.ValidateObject(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateSet implements the validation logic for set attributes.
+func (v RequiredIfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Set)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.Set"))
+
+			continue
+		}
+
+		wrappedResp := &validator.SetResponse{}
+
+		wrapped.ValidateSet(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateString implements the validation logic for string attributes.
+func (v RequiredIfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	ok, diags := v.validate(ctx, req.Path, req.Config, req.ConfigValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if !ok {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.String)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("conditional required", req.Path, w, "validator.String"))
+
+			continue
+		}
+
+		wrappedResp := &validator.StringResponse{}
+
+		wrapped.ValidateString(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
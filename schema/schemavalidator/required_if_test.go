@@ -0,0 +1,344 @@
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiredIfValidateString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConfig := func(enabled tftypes.Value, endpoint tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled":  tftypes.Bool,
+						"endpoint": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled":  enabled,
+					"endpoint": endpoint,
+				},
+			),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		request        validator.StringRequest
+		flagExpression path.Expression
+		wrapped        []validator.Describer
+		expected       *validator.StringResponse
+	}{
+		"flag-true-attribute-null": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringNull(),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, true),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("endpoint"),
+						"Missing Attribute Configuration",
+						"Attribute endpoint must be configured when enabled is true",
+					),
+				},
+			},
+		},
+		"flag-true-attribute-set": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringValue("https://example.com"),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, true),
+					tftypes.NewValue(tftypes.String, "https://example.com"),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			expected:       &validator.StringResponse{},
+		},
+		"flag-true-attribute-unknown": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringUnknown(),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, true),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			expected:       &validator.StringResponse{},
+		},
+		"flag-false": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringNull(),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, false),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			expected:       &validator.StringResponse{},
+		},
+		"flag-null": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringNull(),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, nil),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			expected:       &validator.StringResponse{},
+		},
+		"flag-unknown": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringNull(),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			expected:       &validator.StringResponse{},
+		},
+		"flag-not-bool": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringValue("https://example.com"),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, true),
+					tftypes.NewValue(tftypes.String, "https://example.com"),
+				),
+			},
+			flagExpression: path.MatchRoot("endpoint"),
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("endpoint"),
+						"Invalid Validator Path Expression",
+						"An unexpected value type was encountered while attempting to perform conditional required validation. "+
+							"The path expression must reference an attribute with a value type that implements the basetypes.BoolValuable interface. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path: endpoint\n"+
+							"Value Type: basetypes.StringValue",
+					),
+				},
+			},
+		},
+		"wrapped-flag-true": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringValue("http://example.com"),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, true),
+					tftypes.NewValue(tftypes.String, "http://example.com"),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			wrapped: []validator.Describer{
+				stringvalidator.HasPrefix("https://"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("endpoint"),
+						"Invalid Attribute Value",
+						`Attribute endpoint value must start with "https://", got: "http://example.com"`,
+					),
+				},
+			},
+		},
+		"wrapped-flag-false": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringValue("http://example.com"),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, false),
+					tftypes.NewValue(tftypes.String, "http://example.com"),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			wrapped: []validator.Describer{
+				stringvalidator.HasPrefix("https://"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"wrapped-invalid-type": {
+			request: validator.StringRequest{
+				Path:           path.Root("endpoint"),
+				PathExpression: path.MatchRoot("endpoint"),
+				ConfigValue:    types.StringValue("https://example.com"),
+				Config: testConfig(
+					tftypes.NewValue(tftypes.Bool, true),
+					tftypes.NewValue(tftypes.String, "https://example.com"),
+				),
+			},
+			flagExpression: path.MatchRoot("enabled"),
+			wrapped: []validator.Describer{
+				int64validator.Positive(),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("endpoint"),
+						"Invalid Validator Implementation",
+						"An unexpected validator implementation was encountered while attempting to perform conditional required validation. "+
+							"The wrapped validator must implement the validator.String interface. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Path: endpoint\n"+
+							"Validator Type: int64validator.positiveValidator",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			schemavalidator.RequiredIf(testCase.flagExpression, testCase.wrapped...).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRequiredIfValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"enabled": tftypes.Bool,
+					"port":    tftypes.Number,
+				},
+			},
+			map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"port":    tftypes.NewValue(tftypes.Number, nil),
+			},
+		),
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					Optional: true,
+				},
+				"port": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	resp := &validator.Int64Response{}
+	expected := &validator.Int64Response{
+		Diagnostics: diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("port"),
+				"Missing Attribute Configuration",
+				"Attribute port must be configured when enabled is true",
+			),
+		},
+	}
+
+	schemavalidator.RequiredIf(path.MatchRoot("enabled")).ValidateInt64(
+		context.Background(),
+		validator.Int64Request{
+			Path:           path.Root("port"),
+			PathExpression: path.MatchRoot("port"),
+			ConfigValue:    types.Int64Null(),
+			Config:         testConfig,
+		},
+		resp,
+	)
+
+	if diff := cmp.Diff(expected, resp); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRequiredIfDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator schemavalidator.RequiredIfValidator
+		expected  string
+	}{
+		"no-wrapped": {
+			validator: schemavalidator.RequiredIf(path.MatchRoot("enabled")),
+			expected:  "value must be configured when enabled is true",
+		},
+		"wrapped": {
+			validator: schemavalidator.RequiredIf(
+				path.MatchRoot("enabled"),
+				stringvalidator.HasPrefix("https://"),
+			),
+			expected: `value must be configured when enabled is true, then value must start with "https://"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.Description(context.Background())
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// StringIsKeyOf returns a validator which ensures that any configured string
+// value is a key of the map attribute at the given path expression, such as
+// an attribute which must name one of the keys of another map attribute.
+// Null and unknown values are skipped, as are null and unknown map values at
+// the referenced path.
+//
+// The expression is merged with the path expression of the attribute being
+// validated, so expressions created with path.MatchRoot are resolved from the
+// root of the configuration, while expressions created with
+// path.MatchRelative are resolved from the attribute being validated. If the
+// expression matches multiple maps, the value must be a key of each map.
+func StringIsKeyOf(expression path.Expression) validator.String {
+	return stringIsKeyOfValidator{
+		expression: expression,
+	}
+}
+
+// stringIsKeyOfValidator implements the validator.
+type stringIsKeyOfValidator struct {
+	expression path.Expression
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v stringIsKeyOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a key of the map at %s", v.expression.Resolve())
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v stringIsKeyOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v stringIsKeyOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	expression := req.PathExpression.Merge(v.expression)
+
+	matchedPaths, diags := req.Config.PathMatches(ctx, expression)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	for _, matchedPath := range matchedPaths {
+		var matchedValue attr.Value
+
+		diags := req.Config.GetAttribute(ctx, matchedPath, &matchedValue)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		// A null or unknown value may also be a parent path of the
+		// expression, so these must be checked before the value type.
+		if matchedValue.IsNull() || matchedValue.IsUnknown() {
+			continue
+		}
+
+		mapValuable, ok := matchedValue.(basetypes.MapValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Validator Path Expression",
+				"An unexpected value type was encountered while attempting to perform map key validation. "+
+					"The path expression must reference an attribute with a value type that implements the basetypes.MapValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Path: %s\n", matchedPath)+
+					fmt.Sprintf("Value Type: %T", matchedValue),
+			)
+
+			continue
+		}
+
+		mapValue, diags := mapValuable.ToMapValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		if _, ok := mapValue.Elements()[value]; ok {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s value must be a key of the map at %s, got: %q", req.Path, matchedPath, value),
+		)
+	}
+}
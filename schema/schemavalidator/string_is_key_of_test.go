@@ -0,0 +1,362 @@
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStringIsKeyOfValidateString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"default_pool": schema.StringAttribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"pools": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		request    validator.StringRequest
+		expression path.Expression
+		expected   *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringNull(),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, nil),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.String},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(tftypes.String, "1"),
+									"two": tftypes.NewValue(tftypes.String, "2"),
+								},
+							),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRoot("pools"),
+			expected:   &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringUnknown(),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.String},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(tftypes.String, "1"),
+									"two": tftypes.NewValue(tftypes.String, "2"),
+								},
+							),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRoot("pools"),
+			expected:   &validator.StringResponse{},
+		},
+		"valid-key": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringValue("one"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, "one"),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.String},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(tftypes.String, "1"),
+									"two": tftypes.NewValue(tftypes.String, "2"),
+								},
+							),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRoot("pools"),
+			expected:   &validator.StringResponse{},
+		},
+		"valid-key-relative-expression": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringValue("two"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, "two"),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.String},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(tftypes.String, "1"),
+									"two": tftypes.NewValue(tftypes.String, "2"),
+								},
+							),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRelative().AtParent().AtName("pools"),
+			expected:   &validator.StringResponse{},
+		},
+		"invalid-key": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringValue("three"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, "three"),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.String},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(tftypes.String, "1"),
+									"two": tftypes.NewValue(tftypes.String, "2"),
+								},
+							),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRoot("pools"),
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("default_pool"),
+						"Invalid Attribute Value",
+						`Attribute default_pool value must be a key of the map at pools, got: "three"`,
+					),
+				},
+			},
+		},
+		"invalid-key-relative-expression": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringValue("three"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, "three"),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.String},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(tftypes.String, "1"),
+									"two": tftypes.NewValue(tftypes.String, "2"),
+								},
+							),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRelative().AtParent().AtName("pools"),
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("default_pool"),
+						"Invalid Attribute Value",
+						`Attribute default_pool value must be a key of the map at pools, got: "three"`,
+					),
+				},
+			},
+		},
+		"map-null": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringValue("one"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, "one"),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRoot("pools"),
+			expected:   &validator.StringResponse{},
+		},
+		"map-unknown": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringValue("one"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, "one"),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRoot("pools"),
+			expected:   &validator.StringResponse{},
+		},
+		"expression-not-map": {
+			request: validator.StringRequest{
+				Path:           path.Root("default_pool"),
+				PathExpression: path.MatchRoot("default_pool"),
+				ConfigValue:    types.StringValue("one"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"default_pool": tftypes.String,
+								"name":         tftypes.String,
+								"pools":        tftypes.Map{ElementType: tftypes.String},
+							},
+						},
+						map[string]tftypes.Value{
+							"default_pool": tftypes.NewValue(tftypes.String, "one"),
+							"name":         tftypes.NewValue(tftypes.String, "test"),
+							"pools": tftypes.NewValue(
+								tftypes.Map{ElementType: tftypes.String},
+								map[string]tftypes.Value{
+									"one": tftypes.NewValue(tftypes.String, "1"),
+									"two": tftypes.NewValue(tftypes.String, "2"),
+								},
+							),
+						},
+					),
+					Schema: testSchema,
+				},
+			},
+			expression: path.MatchRoot("name"),
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("default_pool"),
+						"Invalid Validator Path Expression",
+						"An unexpected value type was encountered while attempting to perform map key validation. "+
+							"The path expression must reference an attribute with a value type that implements the basetypes.MapValuable interface. "+
+							"Please report this to the provider developers.\n\n"+
+							"Path: name\n"+
+							"Value Type: basetypes.StringValue",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			schemavalidator.StringIsKeyOf(testCase.expression).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package schemavalidator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.OnApply = ValidateOnApplyValidator{}
+	_ validator.Bool    = ValidateOnApplyValidator{}
+	_ validator.Float64 = ValidateOnApplyValidator{}
+	_ validator.Int64   = ValidateOnApplyValidator{}
+	_ validator.List    = ValidateOnApplyValidator{}
+	_ validator.Map     = ValidateOnApplyValidator{}
+	_ validator.Number  = ValidateOnApplyValidator{}
+	_ validator.Object  = ValidateOnApplyValidator{}
+	_ validator.Set     = ValidateOnApplyValidator{}
+	_ validator.String  = ValidateOnApplyValidator{}
+)
+
+// ValidateOnApply returns a validator which defers the wrapped validators
+// until resource apply when the configured value is unknown, such as a value
+// which references another resource attribute that is only known after
+// apply. During configuration validation, the wrapped validators are called
+// for known and null values, while unknown values are skipped. During
+// resource apply, when all configuration values are known, the framework
+// calls the wrapped validators again before creating or updating the
+// resource. Values which are only partially unknown, such as a list with an
+// unknown element, are not deferred.
+//
+// Each wrapped validator must implement the validator interface for the type
+// of the attribute being validated, such as validator.String for a
+// StringAttribute.
+//
+// The returned validator implements all validator interfaces, so it can be
+// used with any attribute type. Only resource schema attribute and block
+// validators are called during apply.
+func ValidateOnApply(wrapped ...validator.Describer) ValidateOnApplyValidator {
+	return ValidateOnApplyValidator{
+		wrapped: wrapped,
+	}
+}
+
+// ValidateOnApplyValidator is the validator returned by ValidateOnApply.
+type ValidateOnApplyValidator struct {
+	wrapped []validator.Describer
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v ValidateOnApplyValidator) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.wrapped))
+
+	for _, w := range v.wrapped {
+		descriptions = append(descriptions, w.Description(ctx))
+	}
+
+	return strings.Join(descriptions, " and ")
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v ValidateOnApplyValidator) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.wrapped))
+
+	for _, w := range v.wrapped {
+		descriptions = append(descriptions, w.MarkdownDescription(ctx))
+	}
+
+	return strings.Join(descriptions, " and ")
+}
+
+// ValidateOnApply returns true, so the framework also calls the validator
+// during resource apply.
+func (v ValidateOnApplyValidator) ValidateOnApply() bool {
+	return true
+}
+
+// deferred returns true if validation should be deferred until apply.
+func (v ValidateOnApplyValidator) deferred(ctx context.Context, unknown bool) bool {
+	if !unknown {
+		return false
+	}
+
+	logging.FrameworkDebug(ctx, "Deferring validation until apply due to unknown value")
+
+	return true
+}
+
+// ValidateBool implements the validation logic for bool attributes.
+func (v ValidateOnApplyValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Bool)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.Bool"))
+
+			continue
+		}
+
+		wrappedResp := &validator.BoolResponse{}
+
+		wrapped.ValidateBool(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateFloat64 implements the validation logic for float64 attributes.
+func (v ValidateOnApplyValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Float64)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.Float64"))
+
+			continue
+		}
+
+		wrappedResp := &validator.Float64Response{}
+
+		wrapped.ValidateFloat64(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateInt64 implements the validation logic for int64 attributes.
+func (v ValidateOnApplyValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Int64)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.Int64"))
+
+			continue
+		}
+
+		wrappedResp := &validator.Int64Response{}
+
+		wrapped.ValidateInt64(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateList implements the validation logic for list attributes.
+func (v ValidateOnApplyValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.List)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.List"))
+
+			continue
+		}
+
+		wrappedResp := &validator.ListResponse{}
+
+		wrapped.ValidateList(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateMap implements the validation logic for map attributes.
+func (v ValidateOnApplyValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Map)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.Map"))
+
+			continue
+		}
+
+		wrappedResp := &validator.MapResponse{}
+
+		wrapped.ValidateMap(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateNumber implements the validation logic for number attributes.
+func (v ValidateOnApplyValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Number)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.Number"))
+
+			continue
+		}
+
+		wrappedResp := &validator.NumberResponse{}
+
+		wrapped.ValidateNumber(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateObject implements the validation logic for object attributes.
+func (v ValidateOnApplyValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Object)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.Object"))
+
+			continue
+		}
+
+		wrappedResp := &validator.ObjectResponse{}
+
+		wrapped.ValidateObject(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateSet implements the validation logic for set attributes.
+func (v ValidateOnApplyValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.Set)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.Set"))
+
+			continue
+		}
+
+		wrappedResp := &validator.SetResponse{}
+
+		wrapped.ValidateSet(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
+
+// ValidateString implements the validation logic for string attributes.
+func (v ValidateOnApplyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if v.deferred(ctx, req.ConfigValue.IsUnknown()) {
+		return
+	}
+
+	for _, w := range v.wrapped {
+		wrapped, ok := w.(validator.String)
+
+		if !ok {
+			resp.Diagnostics.Append(invalidWrappedValidatorDiag("deferred", req.Path, w, "validator.String"))
+
+			continue
+		}
+
+		wrappedResp := &validator.StringResponse{}
+
+		wrapped.ValidateString(ctx, req, wrappedResp)
+
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	}
+}
@@ -0,0 +1,151 @@
+package schemavalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testUnknownStringValidator raises an error for unknown values, which
+// verifies the wrapped validator is not called for unknown values.
+type testUnknownStringValidator struct{}
+
+func (v testUnknownStringValidator) Description(_ context.Context) string {
+	return "value must be known"
+}
+
+func (v testUnknownStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testUnknownStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Unknown Value", "value must be known")
+	}
+}
+
+func TestValidateOnApplyValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		wrapped  []validator.Describer
+		expected *validator.StringResponse
+	}{
+		"unknown": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringUnknown(),
+			},
+			wrapped: []validator.Describer{
+				testUnknownStringValidator{},
+			},
+			expected: &validator.StringResponse{},
+		},
+		"null": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringNull(),
+			},
+			wrapped: []validator.Describer{
+				stringvalidator.HasPrefix("https://"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"known-valid": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue("https://example.com"),
+			},
+			wrapped: []validator.Describer{
+				stringvalidator.HasPrefix("https://"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"known-invalid": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue("http://example.com"),
+			},
+			wrapped: []validator.Describer{
+				stringvalidator.HasPrefix("https://"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must start with "https://", got: "http://example.com"`,
+					),
+				},
+			},
+		},
+		"wrapped-invalid-type": {
+			request: validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.StringValue("https://example.com"),
+			},
+			wrapped: []validator.Describer{
+				int64validator.Positive(),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Validator Implementation",
+						"An unexpected validator implementation was encountered while attempting to perform deferred validation. "+
+							"The wrapped validator must implement the validator.String interface. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Path: test\n"+
+							"Validator Type: int64validator.positiveValidator",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			schemavalidator.ValidateOnApply(testCase.wrapped...).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateOnApplyValidateOnApply(t *testing.T) {
+	t.Parallel()
+
+	var v validator.Describer = schemavalidator.ValidateOnApply(stringvalidator.HasPrefix("https://"))
+
+	onApplyValidator, ok := v.(validator.OnApply)
+
+	if !ok {
+		t.Fatal("expected validator to implement validator.OnApply")
+	}
+
+	if !onApplyValidator.ValidateOnApply() {
+		t.Error("expected ValidateOnApply to return true")
+	}
+}
@@ -0,0 +1,128 @@
+package setvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTotalValueLengthAtMostValidateSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.SetRequest
+		max      int
+		expected *validator.SetResponse
+	}{
+		"null": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.SetNull(types.StringType),
+			},
+			max:      1,
+			expected: &validator.SetResponse{},
+		},
+		"unknown": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.SetUnknown(types.StringType),
+			},
+			max:      1,
+			expected: &validator.SetResponse{},
+		},
+		"under-limit": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringNull(),
+						types.StringValue("xyz"),
+					},
+				),
+			},
+			max:      6,
+			expected: &validator.SetResponse{},
+		},
+		"over-limit": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringValue("xyz"),
+					},
+				),
+			},
+			max: 5,
+			expected: &validator.SetResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test total length of element values must be at most 5, got total length: 6",
+					),
+				},
+			},
+		},
+		"under-limit-multibyte": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("éé"),
+						types.StringValue("ü"),
+					},
+				),
+			},
+			max:      4,
+			expected: &validator.SetResponse{},
+		},
+		"unknown-element-skipped": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringUnknown(),
+					},
+				),
+			},
+			max:      1,
+			expected: &validator.SetResponse{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.SetResponse{}
+
+			setvalidator.TotalValueLengthAtMost(testCase.max).ValidateSet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package setvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValueStringsAre returns a validator which ensures that each element value
+// of any configured set of strings satisfies all of the given string
+// validators. Element diagnostics are reported at the path of each element.
+// Null and unknown sets, along with unknown element values, are skipped.
+//
+// Use this validator with set attributes with a string element type,
+// including custom string types.
+//
+// Set attributes with a string element type have no nested attribute
+// definitions for the framework element iteration to walk, so this
+// validator iterates the set elements itself. Diagnostics use the same
+// AtSetValue element paths as nested attribute set elements.
+func ValueStringsAre(elementValidators ...validator.String) validator.Set {
+	return valueStringsAreValidator{
+		elementValidators: elementValidators,
+	}
+}
+
+// valueStringsAreValidator implements the validator.
+type valueStringsAreValidator struct {
+	elementValidators []validator.String
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v valueStringsAreValidator) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.elementValidators))
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v valueStringsAreValidator) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.elementValidators))
+
+	for _, elementValidator := range v.elementValidators {
+		descriptions = append(descriptions, elementValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all validations: %s", strings.Join(descriptions, " + "))
+}
+
+// ValidateSet implements the validation logic.
+func (v valueStringsAreValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		elementPath := req.Path.AtSetValue(element)
+
+		elementValuable, ok := element.(basetypes.StringValuable)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid String Element Validator Value Type",
+				"An unexpected element value type was encountered while attempting to perform string element validation. "+
+					"The element value type must implement the basetypes.StringValuable interface. "+
+					"Please report this to the provider developers.\n\n"+
+					fmt.Sprintf("Incoming Element Value Type: %T", element),
+			)
+
+			return
+		}
+
+		elementValue, diags := elementValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if elementValue.IsUnknown() {
+			continue
+		}
+
+		elementReq := validator.StringRequest{
+			Path:           elementPath,
+			PathExpression: req.PathExpression.AtSetValue(element),
+			Config:         req.Config,
+			ConfigValue:    elementValue,
+		}
+
+		for _, elementValidator := range v.elementValidators {
+			elementResp := &validator.StringResponse{}
+
+			elementValidator.ValidateString(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+		}
+	}
+}
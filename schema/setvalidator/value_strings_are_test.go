@@ -0,0 +1,126 @@
+package setvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueStringsAreValidateSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.SetRequest
+		expected *validator.SetResponse
+	}{
+		"null": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.SetNull(types.StringType),
+			},
+			expected: &validator.SetResponse{},
+		},
+		"unknown": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    types.SetUnknown(types.StringType),
+			},
+			expected: &validator.SetResponse{},
+		},
+		"valid": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringUnknown(),
+						types.StringNull(),
+					},
+				),
+			},
+			expected: &validator.SetResponse{},
+		},
+		"invalid": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("abc"),
+						types.StringValue("bad"),
+						types.StringUnknown(),
+						types.StringNull(),
+						types.StringValue("xyz"),
+					},
+				),
+			},
+			expected: &validator.SetResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtSetValue(types.StringValue("bad")),
+						"Invalid Attribute Value",
+						`Attribute test[Value("bad")] value must start with "a", got: "bad"`,
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtSetValue(types.StringValue("xyz")),
+						"Invalid Attribute Value",
+						`Attribute test[Value("xyz")] value must start with "a", got: "xyz"`,
+					),
+				},
+			},
+		},
+		"invalid-element-type": {
+			request: validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue: types.SetValueMust(
+					types.Int64Type,
+					[]attr.Value{
+						types.Int64Value(1),
+					},
+				),
+			},
+			expected: &validator.SetResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtSetValue(types.Int64Value(1)),
+						"Invalid String Element Validator Value Type",
+						"An unexpected element value type was encountered while attempting to perform string element validation. "+
+							"The element value type must implement the basetypes.StringValuable interface. "+
+							"Please report this to the provider developers.\n\n"+
+							"Incoming Element Value Type: basetypes.Int64Value",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.SetResponse{}
+
+			setvalidator.ValueStringsAre(stringvalidator.HasPrefix("a")).ValidateSet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// asciiRangeTable is the unicode.RangeTable of all ASCII characters, which
+// the unicode package does not provide.
+var asciiRangeTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0000, Hi: 0x007f, Stride: 1},
+	},
+	LatinOffset: 1,
+}
+
+// ASCIIOnly returns a validator which ensures that any configured string
+// value only contains ASCII characters, such as identifiers for downstream
+// systems which do not support Unicode. The error diagnostic includes the
+// first disallowed character and its character index. Null and unknown values
+// are skipped.
+//
+// Use CharsetAllowed to permit a different set of characters, such as only
+// printable ASCII characters.
+func ASCIIOnly() validator.String {
+	return charsetAllowedValidator{
+		allowed:     asciiRangeTable,
+		description: "value must only contain ASCII characters",
+	}
+}
+
+// CharsetAllowed returns a validator which ensures that any configured string
+// value only contains characters in the allowed unicode.RangeTable, such as
+// unicode.Latin or a custom table. The error diagnostic includes the first
+// disallowed character and its character index. Null and unknown values are
+// skipped.
+//
+// CharsetAllowed panics if allowed is nil, since no value could be validated.
+func CharsetAllowed(allowed *unicode.RangeTable) validator.String {
+	if allowed == nil {
+		panic("invalid CharsetAllowed allowed: range table must not be nil")
+	}
+
+	return charsetAllowedValidator{
+		allowed:     allowed,
+		description: "value must only contain allowed characters",
+	}
+}
+
+// charsetAllowedValidator implements the validator.
+type charsetAllowedValidator struct {
+	allowed     *unicode.RangeTable
+	description string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v charsetAllowedValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v charsetAllowedValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v charsetAllowedValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	// Track the character index rather than the byte offset, since the
+	// byte offset of multibyte characters is not meaningful to practitioners.
+	var idx int
+
+	for _, r := range value {
+		if !unicode.Is(v.allowed, r) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s %s, got disallowed character %q (%U) at index %d: %q", req.Path, v.Description(ctx), r, r, idx, value),
+			)
+
+			return
+		}
+
+		idx++
+	}
+}
@@ -0,0 +1,194 @@
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+	"unicode"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestASCIIOnlyValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"empty": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue(""),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"ascii": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("my-bucket_01.example ~!@#"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"accented": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("café-é"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must only contain ASCII characters, got disallowed character 'é' (U+00E9) at index 3: "café-é"`,
+					),
+				},
+			},
+		},
+		"emoji": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("name\U0001F600"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must only contain ASCII characters, got disallowed character '\U0001F600' (U+1F600) at index 4: \"name\U0001F600\"",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.ASCIIOnly().ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCharsetAllowedValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"empty": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue(""),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"allowed": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("Café"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"disallowed-ascii": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("Café-1"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must only contain allowed characters, got disallowed character '-' (U+002D) at index 4: "Café-1"`,
+					),
+				},
+			},
+		},
+		"disallowed-emoji": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("\U0001F600"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						"Attribute test value must only contain allowed characters, got disallowed character '\U0001F600' (U+1F600) at index 0: \"\U0001F600\"",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.CharsetAllowed(unicode.Latin).ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCharsetAllowedNil(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic, got none")
+		}
+	}()
+
+	stringvalidator.CharsetAllowed(nil)
+}
@@ -0,0 +1,75 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Contains returns a validator which ensures that any configured string value
+// must contain the given substring. Null and unknown values are skipped.
+//
+// Use ContainsCaseInsensitive to ignore differences in letter case.
+func Contains(substr string) validator.String {
+	return containsValidator{
+		substr: substr,
+	}
+}
+
+// ContainsCaseInsensitive returns a validator which ensures that any configured
+// string value must contain the given substring, ignoring differences in letter
+// case. Null and unknown values are skipped.
+func ContainsCaseInsensitive(substr string) validator.String {
+	return containsValidator{
+		caseInsensitive: true,
+		substr:          substr,
+	}
+}
+
+// containsValidator implements the validator.
+type containsValidator struct {
+	caseInsensitive bool
+	substr          string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v containsValidator) Description(_ context.Context) string {
+	if v.caseInsensitive {
+		return fmt.Sprintf("value must contain %q (case-insensitive)", v.substr)
+	}
+
+	return fmt.Sprintf("value must contain %q", v.substr)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v containsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v containsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	substr := v.substr
+
+	if v.caseInsensitive {
+		value = strings.ToLower(value)
+		substr = strings.ToLower(substr)
+	}
+
+	if strings.Contains(value, substr) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+	)
+}
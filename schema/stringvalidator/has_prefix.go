@@ -0,0 +1,75 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// HasPrefix returns a validator which ensures that any configured string value
+// must start with the given prefix. Null and unknown values are skipped.
+//
+// Use HasPrefixCaseInsensitive to ignore differences in letter case.
+func HasPrefix(prefix string) validator.String {
+	return hasPrefixValidator{
+		prefix: prefix,
+	}
+}
+
+// HasPrefixCaseInsensitive returns a validator which ensures that any configured
+// string value must start with the given prefix, ignoring differences in letter
+// case. Null and unknown values are skipped.
+func HasPrefixCaseInsensitive(prefix string) validator.String {
+	return hasPrefixValidator{
+		caseInsensitive: true,
+		prefix:          prefix,
+	}
+}
+
+// hasPrefixValidator implements the validator.
+type hasPrefixValidator struct {
+	caseInsensitive bool
+	prefix          string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v hasPrefixValidator) Description(_ context.Context) string {
+	if v.caseInsensitive {
+		return fmt.Sprintf("value must start with %q (case-insensitive)", v.prefix)
+	}
+
+	return fmt.Sprintf("value must start with %q", v.prefix)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v hasPrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v hasPrefixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	prefix := v.prefix
+
+	if v.caseInsensitive {
+		value = strings.ToLower(value)
+		prefix = strings.ToLower(prefix)
+	}
+
+	if strings.HasPrefix(value, prefix) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+	)
+}
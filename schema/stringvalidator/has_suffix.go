@@ -0,0 +1,75 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// HasSuffix returns a validator which ensures that any configured string value
+// must end with the given suffix. Null and unknown values are skipped.
+//
+// Use HasSuffixCaseInsensitive to ignore differences in letter case.
+func HasSuffix(suffix string) validator.String {
+	return hasSuffixValidator{
+		suffix: suffix,
+	}
+}
+
+// HasSuffixCaseInsensitive returns a validator which ensures that any configured
+// string value must end with the given suffix, ignoring differences in letter
+// case. Null and unknown values are skipped.
+func HasSuffixCaseInsensitive(suffix string) validator.String {
+	return hasSuffixValidator{
+		caseInsensitive: true,
+		suffix:          suffix,
+	}
+}
+
+// hasSuffixValidator implements the validator.
+type hasSuffixValidator struct {
+	caseInsensitive bool
+	suffix          string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v hasSuffixValidator) Description(_ context.Context) string {
+	if v.caseInsensitive {
+		return fmt.Sprintf("value must end with %q (case-insensitive)", v.suffix)
+	}
+
+	return fmt.Sprintf("value must end with %q", v.suffix)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v hasSuffixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic.
+func (v hasSuffixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	suffix := v.suffix
+
+	if v.caseInsensitive {
+		value = strings.ToLower(value)
+		suffix = strings.ToLower(suffix)
+	}
+
+	if strings.HasSuffix(value, suffix) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+	)
+}
@@ -0,0 +1,152 @@
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHasSuffixValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"match": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("config.json"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"mismatch-middle": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("config.json.bak"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must end with ".json", got: "config.json.bak"`,
+					),
+				},
+			},
+		},
+		"mismatch-case": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("config.JSON"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must end with ".json", got: "config.JSON"`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.HasSuffix(".json").ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHasSuffixCaseInsensitiveValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"match-case": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("config.JSON"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"mismatch": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("config.yaml"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must end with ".json" (case-insensitive), got: "config.yaml"`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			stringvalidator.HasSuffixCaseInsensitive(".json").ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package validator
+
+// Memoizable is an optional interface for validators whose result only
+// depends on the configured value being validated, such as an expensive
+// format check, which the framework can skip calling for equal values. The
+// validator must also implement the validator interface of the attribute
+// type, such as String.
+//
+// During a single configuration validation, once the validator returns no
+// diagnostics for a value, the framework does not call it again for an equal
+// value of the same attribute, such as the same underlying attribute of many
+// nested objects in a large set. Values are equal when their Terraform
+// encoding is equal, including null and unknown values. The validator is
+// always called for values where it previously returned diagnostics, so
+// diagnostics are raised for each path. Validators of nested attribute
+// objects and nested block objects are not memoized.
+//
+// Validators which read other configuration values from the request, such as
+// the Config or PathExpression fields, must not implement this interface.
+//
+// Implementations should typically use the schemavalidator.Memoize function
+// rather than implementing this interface.
+type Memoizable interface {
+	Describer
+
+	// ValidateMemoizable should return true if the framework can skip
+	// calling the validator for values equal to a value which previously
+	// returned no diagnostics.
+	ValidateMemoizable() bool
+}
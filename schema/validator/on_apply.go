@@ -0,0 +1,23 @@
+package validator
+
+// OnApply is an optional interface for validators which should also be called
+// during resource apply, once all configuration values are known, such as a
+// validator which can only succeed with a value that is known after apply.
+// The validator must also implement the validator interface of the attribute
+// type, such as String, which is called with the apply configuration.
+//
+// Validators which implement this interface are still called during
+// configuration validation, where they should skip unknown values.
+// Configuration values are always known during resource apply. Validators are
+// not called during resource apply for resource destruction, nor for
+// validators of nested attribute objects or nested block objects.
+//
+// Implementations should typically use the schemavalidator.ValidateOnApply
+// function rather than implementing this interface.
+type OnApply interface {
+	Describer
+
+	// ValidateOnApply should return true if the validator should be called
+	// during resource apply.
+	ValidateOnApply() bool
+}
@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Update is an optional interface for resource schema validators which also
+// validate the change between the prior state value and the planned value of
+// an attribute, such as a value which may only increase. The validator must
+// also implement the validator interface of the attribute type, such as
+// Int64, to be declared in the attribute Validators field.
+//
+// Update validation is only performed during resource planning when there is
+// prior state and the resource is not planned for destruction, after all plan
+// modification. It is not performed during resource creation or configuration
+// validation, nor for attributes underneath set nested attributes or blocks,
+// since set elements do not have a stable prior state correspondence.
+//
+// Request values are of the attribute value type, which may be a custom
+// value type, so implementations should convert values using the basetypes
+// package Valuable interfaces, such as basetypes.Int64Valuable.
+type Update interface {
+	Describer
+
+	// ValidateUpdate should perform the validation.
+	ValidateUpdate(context.Context, UpdateRequest, *UpdateResponse)
+}
+
+// UpdateRequest is a request for schema update validation.
+type UpdateRequest struct {
+	// Path contains the path of the attribute for validation. Use this path
+	// for any response diagnostics.
+	Path path.Path
+
+	// PathExpression contains the expression matching the exact path
+	// of the attribute for validation.
+	PathExpression path.Expression
+
+	// Config contains the entire configuration of the resource.
+	Config tfsdk.Config
+
+	// ConfigValue contains the value of the attribute for validation from the
+	// configuration.
+	ConfigValue attr.Value
+
+	// Plan contains the entire planned new state of the resource.
+	Plan tfsdk.Plan
+
+	// PlanValue contains the value of the attribute for validation from the
+	// planned new state.
+	PlanValue attr.Value
+
+	// State contains the entire prior state of the resource.
+	State tfsdk.State
+
+	// StateValue contains the value of the attribute for validation from the
+	// prior state.
+	StateValue attr.Value
+}
+
+// UpdateResponse is a response to an UpdateRequest.
+type UpdateResponse struct {
+	// Diagnostics report errors or warnings related to validating the
+	// resource update. An empty slice indicates success, with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,183 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// snapshotVersion is the current version of the snapshot format. It is
+// incremented if the snapshot format changes in an incompatible manner.
+const snapshotVersion = 1
+
+// snapshot is the serialized form of a State or Plan. The value is encoded
+// as MessagePack, which unlike JSON can represent unknown values, and the
+// snapshot itself is JSON so it can be stored in provider private state.
+type snapshot struct {
+	Version int    `json:"version"`
+	Value   []byte `json:"value"`
+}
+
+// Snapshot returns a serialized copy of the state, which can be restored
+// with StateFromSnapshot. The snapshot is valid JSON, so it can be stored in
+// resource private state, such as to save the progress of a long-running or
+// multiple step operation.
+//
+// Null and unknown values are preserved. Sensitivity is defined by the
+// schema rather than the values, so it is preserved by restoring the
+// snapshot with the same schema.
+func (s State) Snapshot(ctx context.Context) ([]byte, diag.Diagnostics) {
+	return dataSnapshot(ctx, s.data())
+}
+
+// StateFromSnapshot returns the State serialized by the State Snapshot
+// method. Error diagnostics are returned if the snapshot is invalid or does
+// not conform to the given schema, such as after an attribute was added,
+// removed, or changed type.
+func StateFromSnapshot(ctx context.Context, schema fwschema.Schema, data []byte) (State, diag.Diagnostics) {
+	raw, diags := dataFromSnapshot(ctx, fwschemadata.DataDescriptionState, schema, data)
+
+	return State{
+		Raw:    raw,
+		Schema: schema,
+	}, diags
+}
+
+// Snapshot returns a serialized copy of the plan, which can be restored with
+// PlanFromSnapshot. It otherwise behaves the same as the State Snapshot
+// method.
+func (p Plan) Snapshot(ctx context.Context) ([]byte, diag.Diagnostics) {
+	return dataSnapshot(ctx, *p.data())
+}
+
+// PlanFromSnapshot returns the Plan serialized by the Plan Snapshot method.
+// It otherwise behaves the same as StateFromSnapshot.
+func PlanFromSnapshot(ctx context.Context, schema fwschema.Schema, data []byte) (Plan, diag.Diagnostics) {
+	raw, diags := dataFromSnapshot(ctx, fwschemadata.DataDescriptionPlan, schema, data)
+
+	return Plan{
+		Raw:    raw,
+		Schema: schema,
+	}, diags
+}
+
+// dataSnapshot returns the serialized snapshot of the data.
+func dataSnapshot(ctx context.Context, d fwschemadata.Data) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if d.Schema == nil {
+		diags.AddError(
+			"Unable to Create "+d.Description.Title()+" Snapshot",
+			"An unexpected error was encountered when creating the "+d.Description.String()+" snapshot. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Missing schema.",
+		)
+
+		return nil, diags
+	}
+
+	value, err := tfprotov6.NewDynamicValue(d.Schema.Type().TerraformType(ctx), d.TerraformValue)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Create "+d.Description.Title()+" Snapshot",
+			"An unexpected error was encountered when creating the "+d.Description.String()+" snapshot. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	result, err := json.Marshal(snapshot{
+		Version: snapshotVersion,
+		Value:   value.MsgPack,
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Create "+d.Description.Title()+" Snapshot",
+			"An unexpected error was encountered when creating the "+d.Description.String()+" snapshot. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// dataFromSnapshot returns the value of the serialized snapshot, validated
+// against the schema. A null value of the schema type is returned with any
+// error diagnostics.
+func dataFromSnapshot(ctx context.Context, description fwschemadata.DataDescription, schema fwschema.Schema, data []byte) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if schema == nil {
+		diags.AddError(
+			"Unable to Restore "+description.Title()+" Snapshot",
+			"An unexpected error was encountered when restoring the "+description.String()+" snapshot. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Missing schema.",
+		)
+
+		return tftypes.Value{}, diags
+	}
+
+	schemaType := schema.Type().TerraformType(ctx)
+	nullValue := tftypes.NewValue(schemaType, nil)
+
+	var s snapshot
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		diags.AddError(
+			"Unable to Restore "+description.Title()+" Snapshot",
+			"An unexpected error was encountered when restoring the "+description.String()+" snapshot. "+
+				"The snapshot data is not valid. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nullValue, diags
+	}
+
+	if s.Version != snapshotVersion {
+		diags.AddError(
+			"Unable to Restore "+description.Title()+" Snapshot",
+			"An unexpected error was encountered when restoring the "+description.String()+" snapshot. "+
+				"The snapshot version is not supported. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Version: %d\n", snapshotVersion)+
+				fmt.Sprintf("Snapshot Version: %d", s.Version),
+		)
+
+		return nullValue, diags
+	}
+
+	dynamicValue := tfprotov6.DynamicValue{
+		MsgPack: s.Value,
+	}
+
+	raw, err := dynamicValue.Unmarshal(schemaType)
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Restore "+description.Title()+" Snapshot",
+			"An unexpected error was encountered when restoring the "+description.String()+" snapshot. "+
+				"The snapshot does not match the schema, which can occur if the schema changed since the snapshot was created. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nullValue, diags
+	}
+
+	return raw, diags
+}
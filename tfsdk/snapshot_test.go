@@ -0,0 +1,285 @@
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"password": testschema.Attribute{
+				Optional:  true,
+				Sensitive: true,
+				Type:      types.StringType,
+			},
+			"nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"count": testschema.Attribute{
+							Optional: true,
+							Type:     types.Int64Type,
+						},
+						"secret": testschema.Attribute{
+							Optional:  true,
+							Sensitive: true,
+							Type:      types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+				Optional:    true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"count":  tftypes.Number,
+			"secret": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":       tftypes.String,
+			"password": tftypes.String,
+			"nested":   tftypes.List{ElementType: testNestedType},
+		},
+	}
+
+	testCases := map[string]struct {
+		raw tftypes.Value
+	}{
+		"null": {
+			raw: tftypes.NewValue(testType, nil),
+		},
+		"known": {
+			raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, "test-id"),
+				"password": tftypes.NewValue(tftypes.String, "test-password"),
+				"nested": tftypes.NewValue(tftypes.List{ElementType: testNestedType}, []tftypes.Value{
+					tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"count":  tftypes.NewValue(tftypes.Number, 1),
+						"secret": tftypes.NewValue(tftypes.String, "test-secret"),
+					}),
+				}),
+			}),
+		},
+		"null-and-unknown": {
+			raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"password": tftypes.NewValue(tftypes.String, nil),
+				"nested": tftypes.NewValue(tftypes.List{ElementType: testNestedType}, []tftypes.Value{
+					tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"count":  tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+						"secret": tftypes.NewValue(tftypes.String, nil),
+					}),
+					tftypes.NewValue(testNestedType, tftypes.UnknownValue),
+				}),
+			}),
+		},
+		"unknown-list": {
+			raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, "test-id"),
+				"password": tftypes.NewValue(tftypes.String, "test-password"),
+				"nested":   tftypes.NewValue(tftypes.List{ElementType: testNestedType}, tftypes.UnknownValue),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{
+				Raw:    testCase.raw,
+				Schema: testSchema,
+			}
+
+			data, diags := state.Snapshot(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected state snapshot diagnostics: %s", diags)
+			}
+
+			gotState, diags := tfsdk.StateFromSnapshot(context.Background(), testSchema, data)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected state restore diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(gotState, state); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+
+			plan := tfsdk.Plan{
+				Raw:    testCase.raw,
+				Schema: testSchema,
+			}
+
+			data, diags = plan.Snapshot(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected plan snapshot diagnostics: %s", diags)
+			}
+
+			gotPlan, diags := tfsdk.PlanFromSnapshot(context.Background(), testSchema, data)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected plan restore diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(gotPlan, plan); diff != "" {
+				t.Errorf("unexpected plan difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateFromSnapshot(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testMismatchedSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Computed: true,
+				Type:     types.Int64Type,
+			},
+		},
+	}
+
+	testSnapshot, diags := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test-id"),
+		}),
+		Schema: testSchema,
+	}.Snapshot(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected snapshot diagnostics: %s", diags)
+	}
+
+	testCases := map[string]struct {
+		schema        fwschema.Schema
+		data          []byte
+		expected      tfsdk.State
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			schema: testSchema,
+			data:   testSnapshot,
+			expected: tfsdk.State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"invalid-json": {
+			schema: testSchema,
+			data:   []byte(`{`),
+			expected: tfsdk.State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Restore State Snapshot",
+					"An unexpected error was encountered when restoring the state snapshot. "+
+						"The snapshot data is not valid. "+
+						"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+						"Error: unexpected end of JSON input",
+				),
+			},
+		},
+		"unsupported-version": {
+			schema: testSchema,
+			data:   []byte(`{"version":2,"value":""}`),
+			expected: tfsdk.State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Restore State Snapshot",
+					"An unexpected error was encountered when restoring the state snapshot. "+
+						"The snapshot version is not supported. "+
+						"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+						"Expected Version: 1\n"+
+						"Snapshot Version: 2",
+				),
+			},
+		},
+		"schema-mismatch": {
+			schema: testMismatchedSchema,
+			data:   testSnapshot,
+			expected: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id": tftypes.Number,
+					},
+				}, nil),
+				Schema: testMismatchedSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Restore State Snapshot",
+					"An unexpected error was encountered when restoring the state snapshot. "+
+						"The snapshot does not match the schema, which can occur if the schema changed since the snapshot was created. "+
+						"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+						"Error: AttributeName(\"id\"): error parsing \"test-id\" as number: number has no digits",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.StateFromSnapshot(context.Background(), testCase.schema, testCase.data)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -48,6 +49,20 @@ func NewBoolValue(value bool) BoolValue {
 	}
 }
 
+// NewBoolValueOrNull creates a Bool with a known value, unless the given
+// value is equal to any of the nullWhen sentinel values, in which case a null
+// Bool is created. This is useful for mapping APIs which use a sentinel
+// value, such as false, to represent an unset value.
+func NewBoolValueOrNull(value bool, nullWhen ...bool) BoolValue {
+	for _, sentinel := range nullWhen {
+		if value == sentinel {
+			return NewBoolNull()
+		}
+	}
+
+	return NewBoolValue(value)
+}
+
 // BoolValue represents a boolean value.
 type BoolValue struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -135,3 +150,16 @@ func (b BoolValue) ValueBool() bool {
 func (b BoolValue) ToBoolValue(context.Context) (BoolValue, diag.Diagnostics) {
 	return b, nil
 }
+
+// ToStringValue returns the Bool as a String, with a known value of "true" or
+// "false". Null and unknown values are converted to a null or unknown String.
+func (b BoolValue) ToStringValue() StringValue {
+	switch b.state {
+	case attr.ValueStateNull:
+		return NewStringNull()
+	case attr.ValueStateUnknown:
+		return NewStringUnknown()
+	default:
+		return NewStringValue(strconv.FormatBool(b.value))
+	}
+}
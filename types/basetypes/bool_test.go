@@ -382,3 +382,83 @@ func TestBoolValueValueBool(t *testing.T) {
 		})
 	}
 }
+
+func TestBoolValueToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    BoolValue
+		expected StringValue
+	}{
+		"known-false": {
+			input:    NewBoolValue(false),
+			expected: NewStringValue("false"),
+		},
+		"known-true": {
+			input:    NewBoolValue(true),
+			expected: NewStringValue("true"),
+		},
+		"null": {
+			input:    NewBoolNull(),
+			expected: NewStringNull(),
+		},
+		"unknown": {
+			input:    NewBoolUnknown(),
+			expected: NewStringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ToStringValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewBoolValueOrNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    bool
+		nullWhen []bool
+		expected BoolValue
+	}{
+		"no-sentinels": {
+			value:    false,
+			nullWhen: nil,
+			expected: NewBoolValue(false),
+		},
+		"value-matching-sentinel": {
+			value:    false,
+			nullWhen: []bool{false},
+			expected: NewBoolNull(),
+		},
+		"value-not-matching-sentinel": {
+			value:    true,
+			nullWhen: []bool{false},
+			expected: NewBoolValue(true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewBoolValueOrNull(testCase.value, testCase.nullWhen...)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
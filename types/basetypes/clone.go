@@ -0,0 +1,225 @@
+package basetypes
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Clone returns a deep copy of the given value. Collection and object
+// values, including any nested collections and objects, are copied so the
+// returned value does not share any underlying elements, attributes, or
+// number data with the original. Null and unknown states are preserved and
+// the returned value is always Equal to the original.
+//
+// Values implementing ListValuable, MapValuable, NumberValuable,
+// ObjectValuable, or SetValuable with a custom type are converted to their
+// base value, cloned, then converted back via their type. If that
+// conversion is not possible, the original value is returned unchanged.
+//
+// This function is intended for logic, such as plan modifiers, that builds
+// a modified copy of a value without mutating the original.
+func Clone(v attr.Value) attr.Value {
+	if v == nil {
+		return nil
+	}
+
+	switch value := v.(type) {
+	case ListValue:
+		return cloneList(value)
+	case MapValue:
+		return cloneMap(value)
+	case NumberValue:
+		return cloneNumber(value)
+	case ObjectValue:
+		return cloneObject(value)
+	case SetValue:
+		return cloneSet(value)
+	}
+
+	ctx := context.Background()
+
+	switch value := v.(type) {
+	case ListValuable:
+		typ, ok := value.Type(ctx).(ListTypable)
+
+		if !ok {
+			return v
+		}
+
+		baseValue, diags := value.ToListValue(ctx)
+
+		if diags.HasError() {
+			return v
+		}
+
+		result, diags := typ.ValueFromList(ctx, cloneList(baseValue))
+
+		if diags.HasError() {
+			return v
+		}
+
+		return result
+	case MapValuable:
+		typ, ok := value.Type(ctx).(MapTypable)
+
+		if !ok {
+			return v
+		}
+
+		baseValue, diags := value.ToMapValue(ctx)
+
+		if diags.HasError() {
+			return v
+		}
+
+		result, diags := typ.ValueFromMap(ctx, cloneMap(baseValue))
+
+		if diags.HasError() {
+			return v
+		}
+
+		return result
+	case NumberValuable:
+		typ, ok := value.Type(ctx).(NumberTypable)
+
+		if !ok {
+			return v
+		}
+
+		baseValue, diags := value.ToNumberValue(ctx)
+
+		if diags.HasError() {
+			return v
+		}
+
+		result, diags := typ.ValueFromNumber(ctx, cloneNumber(baseValue))
+
+		if diags.HasError() {
+			return v
+		}
+
+		return result
+	case ObjectValuable:
+		typ, ok := value.Type(ctx).(ObjectTypable)
+
+		if !ok {
+			return v
+		}
+
+		baseValue, diags := value.ToObjectValue(ctx)
+
+		if diags.HasError() {
+			return v
+		}
+
+		result, diags := typ.ValueFromObject(ctx, cloneObject(baseValue))
+
+		if diags.HasError() {
+			return v
+		}
+
+		return result
+	case SetValuable:
+		typ, ok := value.Type(ctx).(SetTypable)
+
+		if !ok {
+			return v
+		}
+
+		baseValue, diags := value.ToSetValue(ctx)
+
+		if diags.HasError() {
+			return v
+		}
+
+		result, diags := typ.ValueFromSet(ctx, cloneSet(baseValue))
+
+		if diags.HasError() {
+			return v
+		}
+
+		return result
+	}
+
+	// All other framework values, such as BoolValue and StringValue, do not
+	// contain any reference types and are already copied by value.
+	return v
+}
+
+func cloneList(l ListValue) ListValue {
+	l.elements = cloneElements(l.elements)
+
+	return l
+}
+
+func cloneMap(m MapValue) MapValue {
+	if m.elements == nil {
+		return m
+	}
+
+	elements := make(map[string]attr.Value, len(m.elements))
+
+	for key, element := range m.elements {
+		elements[key] = Clone(element)
+	}
+
+	m.elements = elements
+
+	return m
+}
+
+func cloneNumber(n NumberValue) NumberValue {
+	if n.value == nil {
+		return n
+	}
+
+	n.value = new(big.Float).Copy(n.value)
+
+	return n
+}
+
+func cloneObject(o ObjectValue) ObjectValue {
+	if o.attributeTypes != nil {
+		attributeTypes := make(map[string]attr.Type, len(o.attributeTypes))
+
+		for name, attributeType := range o.attributeTypes {
+			attributeTypes[name] = attributeType
+		}
+
+		o.attributeTypes = attributeTypes
+	}
+
+	if o.attributes != nil {
+		attributes := make(map[string]attr.Value, len(o.attributes))
+
+		for name, attribute := range o.attributes {
+			attributes[name] = Clone(attribute)
+		}
+
+		o.attributes = attributes
+	}
+
+	return o
+}
+
+func cloneSet(s SetValue) SetValue {
+	s.elements = cloneElements(s.elements)
+
+	return s
+}
+
+func cloneElements(elements []attr.Value) []attr.Value {
+	if elements == nil {
+		return nil
+	}
+
+	result := make([]attr.Value, len(elements))
+
+	for idx, element := range elements {
+		result[idx] = Clone(element)
+	}
+
+	return result
+}
@@ -0,0 +1,283 @@
+package basetypes
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	nestedObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"list": ListType{ElemType: StringType{}},
+			"map":  MapType{ElemType: NumberType{}},
+		},
+	}
+
+	testCases := map[string]struct {
+		input attr.Value
+	}{
+		"nil": {
+			input: nil,
+		},
+		"bool": {
+			input: NewBoolValue(true),
+		},
+		"bool-null": {
+			input: NewBoolNull(),
+		},
+		"number": {
+			input: NewNumberValue(big.NewFloat(1.5)),
+		},
+		"number-unknown": {
+			input: NewNumberUnknown(),
+		},
+		"string": {
+			input: NewStringValue("test"),
+		},
+		"list": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringNull(),
+					NewStringUnknown(),
+				},
+			),
+		},
+		"list-null": {
+			input: NewListNull(StringType{}),
+		},
+		"list-unknown": {
+			input: NewListUnknown(StringType{}),
+		},
+		"map": {
+			input: NewMapValueMust(
+				NumberType{},
+				map[string]attr.Value{
+					"one": NewNumberValue(big.NewFloat(1)),
+					"two": NewNumberNull(),
+				},
+			),
+		},
+		"map-null": {
+			input: NewMapNull(NumberType{}),
+		},
+		"set": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+				},
+			),
+		},
+		"set-unknown": {
+			input: NewSetUnknown(StringType{}),
+		},
+		"object": {
+			input: NewObjectValueMust(
+				nestedObjectType.AttrTypes,
+				map[string]attr.Value{
+					"list": NewListValueMust(
+						StringType{},
+						[]attr.Value{NewStringValue("one")},
+					),
+					"map": NewMapNull(NumberType{}),
+				},
+			),
+		},
+		"object-null": {
+			input: NewObjectNull(nestedObjectType.AttrTypes),
+		},
+		"list-object-nested": {
+			input: NewListValueMust(
+				nestedObjectType,
+				[]attr.Value{
+					NewObjectValueMust(
+						nestedObjectType.AttrTypes,
+						map[string]attr.Value{
+							"list": NewListValueMust(
+								StringType{},
+								[]attr.Value{NewStringValue("one")},
+							),
+							"map": NewMapValueMust(
+								NumberType{},
+								map[string]attr.Value{
+									"one": NewNumberValue(big.NewFloat(1)),
+								},
+							),
+						},
+					),
+					NewObjectUnknown(nestedObjectType.AttrTypes),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Clone(testCase.input)
+
+			if testCase.input == nil {
+				if got != nil {
+					t.Errorf("expected nil, got: %s", got)
+				}
+
+				return
+			}
+
+			if !got.Equal(testCase.input) {
+				t.Errorf("expected clone to equal original, got: %s, original: %s", got, testCase.input)
+			}
+		})
+	}
+}
+
+func TestCloneIndependence(t *testing.T) {
+	t.Parallel()
+
+	original := NewListValueMust(
+		ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"list":   ListType{ElemType: StringType{}},
+				"number": NumberType{},
+			},
+		},
+		[]attr.Value{
+			NewObjectValueMust(
+				map[string]attr.Type{
+					"list":   ListType{ElemType: StringType{}},
+					"number": NumberType{},
+				},
+				map[string]attr.Value{
+					"list": NewListValueMust(
+						StringType{},
+						[]attr.Value{NewStringValue("original")},
+					),
+					"number": NewNumberValue(big.NewFloat(1)),
+				},
+			),
+		},
+	)
+	expected := NewListValueMust(
+		ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"list":   ListType{ElemType: StringType{}},
+				"number": NumberType{},
+			},
+		},
+		[]attr.Value{
+			NewObjectValueMust(
+				map[string]attr.Type{
+					"list":   ListType{ElemType: StringType{}},
+					"number": NumberType{},
+				},
+				map[string]attr.Value{
+					"list": NewListValueMust(
+						StringType{},
+						[]attr.Value{NewStringValue("original")},
+					),
+					"number": NewNumberValue(big.NewFloat(1)),
+				},
+			),
+		},
+	)
+
+	clone, ok := Clone(original).(ListValue)
+
+	if !ok {
+		t.Fatalf("expected ListValue, got: %T", clone)
+	}
+
+	cloneObject := clone.Elements()[0].(ObjectValue)
+	cloneNestedList := cloneObject.Attributes()["list"].(ListValue)
+
+	// Mutate the underlying data of the clone at every level.
+	cloneNestedList.Elements()[0] = NewStringValue("modified")
+	cloneObject.Attributes()["number"].(NumberValue).ValueBigFloat().SetInt64(2)
+	cloneObject.Attributes()["extra"] = NewStringValue("extra")
+	clone.Elements()[0] = NewObjectUnknown(cloneObject.AttributeTypes(context.Background()))
+
+	if !original.Equal(expected) {
+		t.Errorf("expected original to be unaffected by clone mutation, got: %s", original)
+	}
+}
+
+// testCloneSetType is a custom set type embedding SetType.
+type testCloneSetType struct {
+	SetType
+}
+
+func (t testCloneSetType) Equal(o attr.Type) bool {
+	other, ok := o.(testCloneSetType)
+
+	if !ok {
+		return false
+	}
+
+	return t.SetType.Equal(other.SetType)
+}
+
+func (t testCloneSetType) ValueFromSet(_ context.Context, in SetValue) (SetValuable, diag.Diagnostics) {
+	return testCloneSetValue{SetValue: in}, nil
+}
+
+// testCloneSetValue is a custom set value embedding SetValue.
+type testCloneSetValue struct {
+	SetValue
+}
+
+func (v testCloneSetValue) Equal(o attr.Value) bool {
+	other, ok := o.(testCloneSetValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.SetValue.Equal(other.SetValue)
+}
+
+func (v testCloneSetValue) Type(ctx context.Context) attr.Type {
+	return testCloneSetType{SetType: SetType{ElemType: v.ElementType(ctx)}}
+}
+
+func TestCloneCustomSet(t *testing.T) {
+	t.Parallel()
+
+	original := testCloneSetValue{
+		SetValue: NewSetValueMust(
+			NumberType{},
+			[]attr.Value{NewNumberValue(big.NewFloat(1))},
+		),
+	}
+
+	clone, ok := Clone(original).(testCloneSetValue)
+
+	if !ok {
+		t.Fatalf("expected testCloneSetValue, got: %T", clone)
+	}
+
+	if !clone.Equal(original) {
+		t.Fatalf("expected clone to equal original, got: %s, original: %s", clone, original)
+	}
+
+	// Mutate the underlying data of the clone, which must not be shared
+	// with the original.
+	clone.Elements()[0].(NumberValue).ValueBigFloat().SetInt64(2)
+
+	expected := NewNumberValue(big.NewFloat(1))
+
+	if got := original.Elements()[0]; !got.Equal(expected) {
+		t.Errorf("expected original to be unaffected by clone mutation, got: %s", got)
+	}
+}
@@ -0,0 +1,26 @@
+package basetypes
+
+// ElementsAsOptions is a collection of toggles to control the behavior of
+// the ElementsAsWithOptions method of ListValue, MapValue, and SetValue.
+type ElementsAsOptions struct {
+	// UnhandledNullAsEmpty controls what happens when ElementsAs needs to
+	// put a null value in a type that has no way to preserve that
+	// distinction. When set to true, the type's empty value will be used.
+	// When set to false, an error will be returned.
+	UnhandledNullAsEmpty bool
+
+	// UnhandledUnknownAsEmpty controls what happens when ElementsAs needs
+	// to put an unknown value in a type that has no way to preserve that
+	// distinction. When set to true, the type's empty value will be used.
+	// When set to false, an error will be returned.
+	UnhandledUnknownAsEmpty bool
+
+	// CoerceNumbers controls what happens when ElementsAs needs to put a
+	// number element into a Go number type which cannot losslessly
+	// represent it, such as a fractional number into an int64. When set to
+	// true, the number is converted and a warning diagnostic is returned on
+	// precision loss. Integer types are truncated towards zero and limited
+	// to the range of the type, while float types use the nearest
+	// representable value. When set to false, an error will be returned.
+	CoerceNumbers bool
+}
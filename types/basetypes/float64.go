@@ -3,6 +3,8 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -54,6 +56,20 @@ func NewFloat64Value(value float64) Float64Value {
 	}
 }
 
+// NewFloat64ValueOrNull creates a Float64 with a known value, unless the given
+// value is equal to any of the nullWhen sentinel values, in which case a null
+// Float64 is created. This is useful for mapping APIs which use a sentinel
+// value, such as -1, to represent an unset value.
+func NewFloat64ValueOrNull(value float64, nullWhen ...float64) Float64Value {
+	for _, sentinel := range nullWhen {
+		if value == sentinel {
+			return NewFloat64Null()
+		}
+	}
+
+	return NewFloat64Value(value)
+}
+
 // Float64Value represents a 64-bit floating point value, exposed as a float64.
 type Float64Value struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -131,6 +147,38 @@ func (f Float64Value) String() string {
 	return fmt.Sprintf("%f", f.value)
 }
 
+// StringWithPrecision returns a human-readable representation of the Float64
+// value rounded to at most prec digits after the decimal point, with any
+// trailing zeros removed, such as "0.3" rather than "0.30000000000000004".
+// A negative prec uses the fewest digits necessary to represent the value
+// exactly. The returned string is intended for diagnostics and logging, not
+// for comparison or parsing.
+//
+// Null and unknown values return the same markers as the String method.
+func (f Float64Value) StringWithPrecision(prec int) string {
+	if f.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if f.IsNull() {
+		return attr.NullValueString
+	}
+
+	result := strconv.FormatFloat(f.value, 'f', prec, 64)
+
+	if strings.Contains(result, ".") {
+		result = strings.TrimRight(result, "0")
+		result = strings.TrimSuffix(result, ".")
+	}
+
+	// Negative values which round to zero should not display a sign.
+	if result == "-0" {
+		return "0"
+	}
+
+	return result
+}
+
 // ValueFloat64 returns the known float64 value. If Float64 is null or unknown, returns
 // 0.0.
 func (f Float64Value) ValueFloat64() float64 {
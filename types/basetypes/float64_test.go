@@ -341,6 +341,95 @@ func TestFloat64ValueString(t *testing.T) {
 	}
 }
 
+func TestFloat64ValueStringWithPrecision(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float64Value
+		prec        int
+		expectation string
+	}
+	tests := map[string]testCase{
+		"imprecise-prec-6": {
+			input:       NewFloat64Value(0.30000000000000004),
+			prec:        6,
+			expectation: "0.3",
+		},
+		"imprecise-prec-20": {
+			input:       NewFloat64Value(0.30000000000000004),
+			prec:        20,
+			expectation: "0.30000000000000004441",
+		},
+		"imprecise-prec-negative": {
+			input:       NewFloat64Value(0.30000000000000004),
+			prec:        -1,
+			expectation: "0.30000000000000004",
+		},
+		"less-than-one-prec-0": {
+			input:       NewFloat64Value(0.12340984302980000),
+			prec:        0,
+			expectation: "0",
+		},
+		"less-than-one-prec-2": {
+			input:       NewFloat64Value(0.12340984302980000),
+			prec:        2,
+			expectation: "0.12",
+		},
+		"less-than-one-prec-4": {
+			input:       NewFloat64Value(0.12340984302980000),
+			prec:        4,
+			expectation: "0.1234",
+		},
+		"more-than-one-prec-2": {
+			input:       NewFloat64Value(92387938173219.327663),
+			prec:        2,
+			expectation: "92387938173219.33",
+		},
+		"whole-number-prec-6": {
+			input:       NewFloat64Value(100),
+			prec:        6,
+			expectation: "100",
+		},
+		"negative-prec-3": {
+			input:       NewFloat64Value(-1.23456),
+			prec:        3,
+			expectation: "-1.235",
+		},
+		"negative-rounds-to-zero": {
+			input:       NewFloat64Value(-0.0000001),
+			prec:        6,
+			expectation: "0",
+		},
+		"unknown": {
+			input:       NewFloat64Unknown(),
+			prec:        6,
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       NewFloat64Null(),
+			prec:        6,
+			expectation: "<null>",
+		},
+		"zero-value": {
+			input:       Float64Value{},
+			prec:        6,
+			expectation: "<null>",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.StringWithPrecision(test.prec)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
 func TestFloat64ValueValueFloat64(t *testing.T) {
 	t.Parallel()
 
@@ -376,3 +465,48 @@ func TestFloat64ValueValueFloat64(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFloat64ValueOrNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    float64
+		nullWhen []float64
+		expected Float64Value
+	}{
+		"no-sentinels": {
+			value:    -1,
+			nullWhen: nil,
+			expected: NewFloat64Value(-1),
+		},
+		"value-matching-sentinel": {
+			value:    -1,
+			nullWhen: []float64{-1},
+			expected: NewFloat64Null(),
+		},
+		"value-not-matching-sentinel": {
+			value:    1.5,
+			nullWhen: []float64{-1},
+			expected: NewFloat64Value(1.5),
+		},
+		"value-matching-multiple-sentinels": {
+			value:    0,
+			nullWhen: []float64{-1, 0},
+			expected: NewFloat64Null(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewFloat64ValueOrNull(testCase.value, testCase.nullWhen...)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
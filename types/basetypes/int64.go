@@ -48,6 +48,20 @@ func NewInt64Value(value int64) Int64Value {
 	}
 }
 
+// NewInt64ValueOrNull creates a Int64 with a known value, unless the given
+// value is equal to any of the nullWhen sentinel values, in which case a null
+// Int64 is created. This is useful for mapping APIs which use a sentinel
+// value, such as -1, to represent an unset value.
+func NewInt64ValueOrNull(value int64, nullWhen ...int64) Int64Value {
+	for _, sentinel := range nullWhen {
+		if value == sentinel {
+			return NewInt64Null()
+		}
+	}
+
+	return NewInt64Value(value)
+}
+
 // Int64Value represents a 64-bit integer value, exposed as an int64.
 type Int64Value struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -344,3 +344,53 @@ func TestInt64ValueValueInt64(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInt64ValueOrNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    int64
+		nullWhen []int64
+		expected Int64Value
+	}{
+		"no-sentinels": {
+			value:    -1,
+			nullWhen: nil,
+			expected: NewInt64Value(-1),
+		},
+		"value-matching-sentinel": {
+			value:    -1,
+			nullWhen: []int64{-1},
+			expected: NewInt64Null(),
+		},
+		"value-not-matching-sentinel": {
+			value:    0,
+			nullWhen: []int64{-1},
+			expected: NewInt64Value(0),
+		},
+		"value-matching-multiple-sentinels": {
+			value:    0,
+			nullWhen: []int64{-1, 0},
+			expected: NewInt64Null(),
+		},
+		"value-not-matching-multiple-sentinels": {
+			value:    1,
+			nullWhen: []int64{-1, 0},
+			expected: NewInt64Value(1),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewInt64ValueOrNull(testCase.value, testCase.nullWhen...)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -318,6 +318,18 @@ func (l ListValue) Elements() []attr.Value {
 // ElementsAs populates `target` with the elements of the ListValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	opts := ElementsAsOptions{
+		UnhandledNullAsEmpty:    allowUnhandled,
+		UnhandledUnknownAsEmpty: allowUnhandled,
+	}
+
+	return l.ElementsAsWithOptions(ctx, target, opts)
+}
+
+// ElementsAsWithOptions populates `target` with the elements of the ListValue,
+// throwing an error if the elements cannot be stored in `target`. The opts
+// control how values which cannot be directly stored in `target` are handled.
+func (l ListValue) ElementsAsWithOptions(ctx context.Context, target interface{}, opts ElementsAsOptions) diag.Diagnostics {
 	// we need a tftypes.Value for this List to be able to use it with our
 	// reflection code
 	values, err := l.ToTerraformValue(ctx)
@@ -330,8 +342,9 @@ func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnha
 		}
 	}
 	return reflect.Into(ctx, ListType{ElemType: l.elementType}, values, target, reflect.Options{
-		UnhandledNullAsEmpty:    allowUnhandled,
-		UnhandledUnknownAsEmpty: allowUnhandled,
+		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
+		CoerceNumbers:           opts.CoerceNumbers,
 	}, path.Empty())
 }
 
@@ -425,6 +438,28 @@ func (l ListValue) IsUnknown() bool {
 	return l.state == attr.ValueStateUnknown
 }
 
+// IsEmpty returns true if the List is null or known without any elements.
+// An unknown List returns false, as its elements may eventually be present.
+// Use IsNull to differentiate between a null and an empty List.
+func (l ListValue) IsEmpty() bool {
+	if l.state == attr.ValueStateUnknown {
+		return false
+	}
+
+	return len(l.elements) == 0
+}
+
+// Len returns the number of elements in the List. A null or unknown List
+// returns 0. Use IsNull and IsUnknown to differentiate between these and an
+// empty List.
+func (l ListValue) Len() int {
+	if l.state != attr.ValueStateKnown {
+		return 0
+	}
+
+	return len(l.elements)
+}
+
 // String returns a human-readable representation of the List value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
@@ -455,3 +490,36 @@ func (l ListValue) String() string {
 func (l ListValue) ToListValue(context.Context) (ListValue, diag.Diagnostics) {
 	return l, nil
 }
+
+// ToSet returns a Set with the same element type and elements as the List.
+// Duplicate elements are collapsed into a single Set element, so the
+// resulting Set may contain fewer elements. A null or unknown List returns a
+// null or unknown Set respectively.
+func (l ListValue) ToSet(_ context.Context) (SetValue, diag.Diagnostics) {
+	switch l.state {
+	case attr.ValueStateNull:
+		return NewSetNull(l.elementType), nil
+	case attr.ValueStateUnknown:
+		return NewSetUnknown(l.elementType), nil
+	}
+
+	elements := make([]attr.Value, 0, len(l.elements))
+
+	for _, element := range l.elements {
+		var duplicate bool
+
+		for _, existing := range elements {
+			if existing.Equal(element) {
+				duplicate = true
+
+				break
+			}
+		}
+
+		if !duplicate {
+			elements = append(elements, element)
+		}
+	}
+
+	return NewSetValue(l.elementType, elements)
+}
@@ -2,6 +2,7 @@ package basetypes
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -488,6 +489,114 @@ func TestListElementsAs_attributeValueSlice(t *testing.T) {
 	}
 }
 
+func TestListElementsAsWithOptions_int64Slice(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		list          ListValue
+		opts          ElementsAsOptions
+		expected      []int64
+		expectedDiags diag.Diagnostics
+	}{
+		"integers": {
+			list: NewListValueMust(
+				NumberType{},
+				[]attr.Value{
+					NewNumberValue(big.NewFloat(1)),
+					NewNumberValue(big.NewFloat(2)),
+				},
+			),
+			opts: ElementsAsOptions{
+				CoerceNumbers: true,
+			},
+			expected: []int64{1, 2},
+		},
+		"fractional": {
+			list: NewListValueMust(
+				NumberType{},
+				[]attr.Value{
+					NewNumberValue(big.NewFloat(1)),
+					NewNumberValue(big.NewFloat(2.5)),
+				},
+			),
+			opts: ElementsAsOptions{
+				CoerceNumbers: true,
+			},
+			expected: []int64{1, 2},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Empty().AtListIndex(1),
+					"Number Precision Loss",
+					"The number 2.5 cannot be exactly represented as int64 and was converted to 2. "+
+						"Verify the configured value or contact the provider developers if this value is expected to be supported.",
+				),
+			},
+		},
+		"fractional-strict": {
+			list: NewListValueMust(
+				NumberType{},
+				[]attr.Value{
+					NewNumberValue(big.NewFloat(1)),
+					NewNumberValue(big.NewFloat(2.5)),
+				},
+			),
+			opts: ElementsAsOptions{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(1),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\ncannot store 2.5 in int64",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := make([]int64, 0)
+
+			diags := testCase.list.ElementsAsWithOptions(context.Background(), &target, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListElementsAsWithOptions_float64Slice(t *testing.T) {
+	t.Parallel()
+
+	var target []float64
+	expected := []float64{1, 2.5}
+
+	diags := NewListValueMust(
+		NumberType{},
+		[]attr.Value{
+			NewNumberValue(big.NewFloat(1)),
+			NewNumberValue(big.NewFloat(2.5)),
+		},
+	).ElementsAsWithOptions(context.Background(), &target, ElementsAsOptions{CoerceNumbers: true})
+	if len(diags) > 0 {
+		t.Errorf("Unexpected diagnostics: %v", diags)
+	}
+	if diff := cmp.Diff(target, expected); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+}
+
 func TestListValueToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -896,6 +1005,86 @@ func TestListValueIsUnknown(t *testing.T) {
 	}
 }
 
+func TestListValueIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValue
+		expected bool
+	}{
+		"known": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test1"), NewStringValue("test2")}),
+			expected: false,
+		},
+		"known-empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: true,
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsEmpty()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueLen(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValue
+		expected int
+	}{
+		"known": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test1"), NewStringValue("test2")}),
+			expected: 2,
+		},
+		"known-empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: 0,
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Len()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListValueString(t *testing.T) {
 	t.Parallel()
 
@@ -1089,3 +1278,67 @@ func TestListTypeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestListValueToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValue
+		expected SetValue
+	}{
+		"known": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			}),
+		},
+		"known-duplicates": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringValue("a"),
+				NewStringNull(),
+				NewStringNull(),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringNull(),
+			}),
+		},
+		"known-empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{}),
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: NewSetNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ToSet(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
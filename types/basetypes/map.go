@@ -323,6 +323,18 @@ func (m MapValue) Elements() map[string]attr.Value {
 // ElementsAs populates `target` with the elements of the MapValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	opts := ElementsAsOptions{
+		UnhandledNullAsEmpty:    allowUnhandled,
+		UnhandledUnknownAsEmpty: allowUnhandled,
+	}
+
+	return m.ElementsAsWithOptions(ctx, target, opts)
+}
+
+// ElementsAsWithOptions populates `target` with the elements of the MapValue,
+// throwing an error if the elements cannot be stored in `target`. The opts
+// control how values which cannot be directly stored in `target` are handled.
+func (m MapValue) ElementsAsWithOptions(ctx context.Context, target interface{}, opts ElementsAsOptions) diag.Diagnostics {
 	// we need a tftypes.Value for this Map to be able to use it with our
 	// reflection code
 	val, err := m.ToTerraformValue(ctx)
@@ -337,8 +349,9 @@ func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhan
 	}
 
 	return reflect.Into(ctx, MapType{ElemType: m.elementType}, val, target, reflect.Options{
-		UnhandledNullAsEmpty:    allowUnhandled,
-		UnhandledUnknownAsEmpty: allowUnhandled,
+		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
+		CoerceNumbers:           opts.CoerceNumbers,
 	}, path.Empty())
 }
 
@@ -432,6 +445,28 @@ func (m MapValue) IsUnknown() bool {
 	return m.state == attr.ValueStateUnknown
 }
 
+// IsEmpty returns true if the Map is null or known without any elements.
+// An unknown Map returns false, as its elements may eventually be present.
+// Use IsNull to differentiate between a null and an empty Map.
+func (m MapValue) IsEmpty() bool {
+	if m.state == attr.ValueStateUnknown {
+		return false
+	}
+
+	return len(m.elements) == 0
+}
+
+// Len returns the number of elements in the Map. A null or unknown Map
+// returns 0. Use IsNull and IsUnknown to differentiate between these and an
+// empty Map.
+func (m MapValue) Len() int {
+	if m.state != attr.ValueStateKnown {
+		return 0
+	}
+
+	return len(m.elements)
+}
+
 // String returns a human-readable representation of the Map value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
@@ -878,6 +878,86 @@ func TestMapValueIsUnknown(t *testing.T) {
 	}
 }
 
+func TestMapValueIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected bool
+	}{
+		"known": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"test-key1": NewStringValue("test1"), "test-key2": NewStringValue("test2")}),
+			expected: false,
+		},
+		"known-empty": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expected: true,
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsEmpty()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueLen(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected int
+	}{
+		"known": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"test-key1": NewStringValue("test1"), "test-key2": NewStringValue("test2")}),
+			expected: 2,
+		},
+		"known-empty": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expected: 0,
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Len()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapValueString(t *testing.T) {
 	t.Parallel()
 
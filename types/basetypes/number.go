@@ -53,6 +53,25 @@ func NewNumberValue(value *big.Float) NumberValue {
 	}
 }
 
+// NewNumberValueOrNull creates a Number with a known value, unless the given
+// value is nil or equal to any of the nullWhen sentinel values, in which case
+// a null Number is created. Values are compared with the *big.Float Cmp
+// method. This is useful for mapping APIs which use a sentinel value, such as
+// -1, to represent an unset value.
+func NewNumberValueOrNull(value *big.Float, nullWhen ...*big.Float) NumberValue {
+	if value == nil {
+		return NewNumberNull()
+	}
+
+	for _, sentinel := range nullWhen {
+		if sentinel != nil && value.Cmp(sentinel) == 0 {
+			return NewNumberNull()
+		}
+	}
+
+	return NewNumberValue(value)
+}
+
 // NumberValue represents a number value, exposed as a *big.Float. Numbers can be
 // floats or integers.
 type NumberValue struct {
@@ -415,3 +415,53 @@ func TestNumberValueValueBigFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestNewNumberValueOrNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    *big.Float
+		nullWhen []*big.Float
+		expected NumberValue
+	}{
+		"nil": {
+			value:    nil,
+			nullWhen: nil,
+			expected: NewNumberNull(),
+		},
+		"no-sentinels": {
+			value:    big.NewFloat(-1),
+			nullWhen: nil,
+			expected: NewNumberValue(big.NewFloat(-1)),
+		},
+		"value-matching-sentinel": {
+			value:    big.NewFloat(-1),
+			nullWhen: []*big.Float{big.NewFloat(-1)},
+			expected: NewNumberNull(),
+		},
+		"value-not-matching-sentinel": {
+			value:    big.NewFloat(1.5),
+			nullWhen: []*big.Float{big.NewFloat(-1)},
+			expected: NewNumberValue(big.NewFloat(1.5)),
+		},
+		"value-matching-multiple-sentinels": {
+			value:    big.NewFloat(0),
+			nullWhen: []*big.Float{nil, big.NewFloat(-1), big.NewFloat(0)},
+			expected: NewNumberNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewNumberValueOrNull(testCase.value, testCase.nullWhen...)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -128,6 +128,94 @@ func (o ObjectType) Equal(candidate attr.Type) bool {
 	return true
 }
 
+// Diff returns human-readable descriptions of each attribute type difference
+// between the ObjectType and `other`, or an empty result if they are equal.
+// Attributes missing from `other`, extra attributes in `other`, and
+// attributes with mismatched types are reported, ordered by attribute name.
+// Nested object types, including object types within list, map, and set
+// types, are compared recursively, where collection elements are denoted by
+// [*] in the attribute name.
+func (o ObjectType) Diff(other ObjectType) []string {
+	return objectTypeDiff("", o, other)
+}
+
+// objectTypeDiff returns the differences between two object types, prefixing
+// any attribute names with the given prefix.
+func objectTypeDiff(prefix string, o ObjectType, other ObjectType) []string {
+	var result []string
+
+	names := make([]string, 0, len(o.AttrTypes)+len(other.AttrTypes))
+
+	for name := range o.AttrTypes {
+		names = append(names, name)
+	}
+
+	for name := range other.AttrTypes {
+		if _, ok := o.AttrTypes[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		attrType, ok := o.AttrTypes[name]
+		otherAttrType, otherOk := other.AttrTypes[name]
+
+		switch {
+		case !otherOk:
+			result = append(result, fmt.Sprintf("%s%s: missing attribute of type %s", prefix, name, attrType))
+		case !ok:
+			result = append(result, fmt.Sprintf("%s%s: extra attribute of type %s", prefix, name, otherAttrType))
+		default:
+			result = append(result, attrTypeDiff(prefix+name, attrType, otherAttrType)...)
+		}
+	}
+
+	return result
+}
+
+// attrTypeDiff returns the differences between two attribute types at the
+// given attribute name, recursing into object and collection types.
+func attrTypeDiff(name string, typ attr.Type, other attr.Type) []string {
+	if typ == nil && other == nil {
+		return nil
+	}
+
+	mismatch := []string{
+		fmt.Sprintf("%s: expected type %s, got %s", name, typ, other),
+	}
+
+	if typ == nil || other == nil {
+		return mismatch
+	}
+
+	switch typ := typ.(type) {
+	case ObjectType:
+		if other, ok := other.(ObjectType); ok {
+			return objectTypeDiff(name+".", typ, other)
+		}
+	case ListType:
+		if other, ok := other.(ListType); ok {
+			return attrTypeDiff(name+"[*]", typ.ElemType, other.ElemType)
+		}
+	case MapType:
+		if other, ok := other.(MapType); ok {
+			return attrTypeDiff(name+"[*]", typ.ElemType, other.ElemType)
+		}
+	case SetType:
+		if other, ok := other.(SetType); ok {
+			return attrTypeDiff(name+"[*]", typ.ElemType, other.ElemType)
+		}
+	default:
+		if typ.Equal(other) {
+			return nil
+		}
+	}
+
+	return mismatch
+}
+
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
 // object.
 func (o ObjectType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
@@ -333,6 +421,15 @@ type ObjectAsOptions struct {
 	// distinction. When set to true, the type's empty value will be used.
 	// When set to false, an error will be returned.
 	UnhandledUnknownAsEmpty bool
+
+	// AllowMissingFields controls what happens when As needs to put an
+	// object into a struct which does not define a field for every object
+	// attribute. When set to true, those object attributes are ignored,
+	// which enables decoding a subset of the object attributes. When set
+	// to false, an error will be returned. Struct fields which do not
+	// correspond to an object attribute always return an error. This
+	// setting also applies to nested objects.
+	AllowMissingFields bool
 }
 
 // As populates `target` with the data in the ObjectValue, throwing an error if the
@@ -353,6 +450,7 @@ func (o ObjectValue) As(ctx context.Context, target interface{}, opts ObjectAsOp
 	return reflect.Into(ctx, obj, val, target, reflect.Options{
 		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
 		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
+		AllowMissingFields:      opts.AllowMissingFields,
 	}, path.Empty())
 }
 
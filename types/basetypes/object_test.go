@@ -2,12 +2,15 @@ package basetypes
 
 import (
 	"context"
+	"errors"
 	"math/big"
+	goreflect "reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -916,6 +919,134 @@ func TestObjectAs_struct(t *testing.T) {
 	}
 }
 
+func TestObjectAs_structAllowMissingFields(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		A string    `tfsdk:"a"`
+		B BoolValue `tfsdk:"b"`
+	}
+
+	object := NewObjectValueMust(
+		map[string]attr.Type{
+			"a": StringType{},
+			"b": BoolType{},
+			"c": NumberType{},
+		},
+		map[string]attr.Value{
+			"a": NewStringValue("hello"),
+			"b": NewBoolValue(true),
+			"c": NewNumberValue(big.NewFloat(123)),
+		},
+	)
+
+	testCases := map[string]struct {
+		opts          ObjectAsOptions
+		expected      myStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"strict": {
+			opts: ObjectAsOptions{},
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), reflect.DiagIntoIncompatibleType{
+					Val: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"a": tftypes.String,
+								"b": tftypes.Bool,
+								"c": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"a": tftypes.NewValue(tftypes.String, "hello"),
+							"b": tftypes.NewValue(tftypes.Bool, true),
+							"c": tftypes.NewValue(tftypes.Number, 123),
+						},
+					),
+					TargetType: goreflect.TypeOf(myStruct{}),
+					Err:        errors.New("mismatch between struct and object: Object defines fields not found in struct: c."),
+				}),
+			},
+		},
+		"allow-missing-fields": {
+			opts: ObjectAsOptions{
+				AllowMissingFields: true,
+			},
+			expected: myStruct{
+				A: "hello",
+				B: NewBoolValue(true),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target myStruct
+
+			diags := object.As(context.Background(), &target, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectAs_structAllowMissingFieldsStructExtraField(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		A string `tfsdk:"a"`
+		D string `tfsdk:"d"`
+	}
+
+	object := NewObjectValueMust(
+		map[string]attr.Type{
+			"a": StringType{},
+			"b": BoolType{},
+		},
+		map[string]attr.Value{
+			"a": NewStringValue("hello"),
+			"b": NewBoolValue(true),
+		},
+	)
+
+	var target myStruct
+
+	diags := object.As(context.Background(), &target, ObjectAsOptions{AllowMissingFields: true})
+
+	expectedDiags := diag.Diagnostics{
+		diag.WithPath(path.Empty(), reflect.DiagIntoIncompatibleType{
+			Val: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"a": tftypes.String,
+						"b": tftypes.Bool,
+					},
+				},
+				map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.String, "hello"),
+					"b": tftypes.NewValue(tftypes.Bool, true),
+				},
+			),
+			TargetType: goreflect.TypeOf(myStruct{}),
+			Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: d."),
+		}),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
 func TestObjectValueAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -2066,3 +2197,160 @@ func TestObjectValueType(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectTypeDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver ObjectType
+		input    ObjectType
+		expected []string
+	}{
+		"equal": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+					"b": ListType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"c": BoolType{}}}},
+				},
+			},
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+					"b": ListType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"c": BoolType{}}}},
+				},
+			},
+			expected: nil,
+		},
+		"missing-attribute": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+					"b": NumberType{},
+				},
+			},
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+			},
+			expected: []string{
+				"b: missing attribute of type basetypes.NumberType",
+			},
+		},
+		"extra-attribute": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+			},
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+					"b": NumberType{},
+				},
+			},
+			expected: []string{
+				"b: extra attribute of type basetypes.NumberType",
+			},
+		},
+		"type-mismatch": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+			},
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": BoolType{},
+				},
+			},
+			expected: []string{
+				"a: expected type basetypes.StringType, got basetypes.BoolType",
+			},
+		},
+		"nested-object-type-mismatch": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"b": ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"c": StringType{},
+									"d": StringType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"b": ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"c": Int64Type{},
+									"e": StringType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"a.b.c: expected type basetypes.StringType, got basetypes.Int64Type",
+				"a.b.d: missing attribute of type basetypes.StringType",
+				"a.b.e: extra attribute of type basetypes.StringType",
+			},
+		},
+		"collection-object-type-mismatch": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"list": ListType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"a": StringType{}}}},
+					"map":  MapType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"a": StringType{}}}},
+					"set":  SetType{ElemType: SetType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"a": StringType{}}}}},
+				},
+			},
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"list": ListType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"a": BoolType{}}}},
+					"map":  MapType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"a": StringType{}}}},
+					"set":  SetType{ElemType: SetType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{}}}},
+				},
+			},
+			expected: []string{
+				"list[*].a: expected type basetypes.StringType, got basetypes.BoolType",
+				"set[*][*].a: missing attribute of type basetypes.StringType",
+			},
+		},
+		"collection-type-mismatch": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": ListType{ElemType: StringType{}},
+				},
+			},
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": SetType{ElemType: StringType{}},
+				},
+			},
+			expected: []string{
+				"a: expected type types.ListType[basetypes.StringType], got types.SetType[basetypes.StringType]",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Diff(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -350,6 +352,18 @@ func (s SetValue) Elements() []attr.Value {
 // ElementsAs populates `target` with the elements of the SetValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (s SetValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
+	opts := ElementsAsOptions{
+		UnhandledNullAsEmpty:    allowUnhandled,
+		UnhandledUnknownAsEmpty: allowUnhandled,
+	}
+
+	return s.ElementsAsWithOptions(ctx, target, opts)
+}
+
+// ElementsAsWithOptions populates `target` with the elements of the SetValue,
+// throwing an error if the elements cannot be stored in `target`. The opts
+// control how values which cannot be directly stored in `target` are handled.
+func (s SetValue) ElementsAsWithOptions(ctx context.Context, target interface{}, opts ElementsAsOptions) diag.Diagnostics {
 	// we need a tftypes.Value for this Set to be able to use it with our
 	// reflection code
 	val, err := s.ToTerraformValue(ctx)
@@ -362,8 +376,9 @@ func (s SetValue) ElementsAs(ctx context.Context, target interface{}, allowUnhan
 		}
 	}
 	return reflect.Into(ctx, s.Type(ctx), val, target, reflect.Options{
-		UnhandledNullAsEmpty:    allowUnhandled,
-		UnhandledUnknownAsEmpty: allowUnhandled,
+		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
+		CoerceNumbers:           opts.CoerceNumbers,
 	}, path.Empty())
 }
 
@@ -465,6 +480,28 @@ func (s SetValue) IsUnknown() bool {
 	return s.state == attr.ValueStateUnknown
 }
 
+// IsEmpty returns true if the Set is null or known without any elements.
+// An unknown Set returns false, as its elements may eventually be present.
+// Use IsNull to differentiate between a null and an empty Set.
+func (s SetValue) IsEmpty() bool {
+	if s.state == attr.ValueStateUnknown {
+		return false
+	}
+
+	return len(s.elements) == 0
+}
+
+// Len returns the number of elements in the Set. A null or unknown Set
+// returns 0. Use IsNull and IsUnknown to differentiate between these and an
+// empty Set.
+func (s SetValue) Len() int {
+	if s.state != attr.ValueStateKnown {
+		return 0
+	}
+
+	return len(s.elements)
+}
+
 // String returns a human-readable representation of the Set value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
@@ -495,3 +532,93 @@ func (s SetValue) String() string {
 func (s SetValue) ToSetValue(context.Context) (SetValue, diag.Diagnostics) {
 	return s, nil
 }
+
+// ToList returns a List with the same element type and elements as the Set.
+// Since Set elements are unordered, List elements are sorted, so the
+// resulting List is the same regardless of the order the Set elements were
+// given. Known number, float64, and int64 elements are ordered by value, such
+// as 9 before 10, and any other elements are ordered by their String
+// representation after any numbers. A null or unknown Set returns a null or
+// unknown List respectively.
+func (s SetValue) ToList(ctx context.Context) (ListValue, diag.Diagnostics) {
+	switch s.state {
+	case attr.ValueStateNull:
+		return NewListNull(s.elementType), nil
+	case attr.ValueStateUnknown:
+		return NewListUnknown(s.elementType), nil
+	}
+
+	keys := make([]setListKey, len(s.elements))
+
+	for idx, element := range s.elements {
+		keys[idx] = newSetListKey(ctx, element)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].less(keys[j])
+	})
+
+	elements := make([]attr.Value, len(keys))
+
+	for idx, key := range keys {
+		elements[idx] = key.element
+	}
+
+	return NewListValue(s.elementType, elements)
+}
+
+// setListKey is the sort key of a Set element when converting to a List.
+// Numbers are compared by value, otherwise strings are compared lexically.
+type setListKey struct {
+	element attr.Value
+	number  *big.Float
+	str     string
+}
+
+// newSetListKey returns the setListKey of a Set element.
+func newSetListKey(ctx context.Context, element attr.Value) setListKey {
+	key := setListKey{
+		element: element,
+		str:     element.String(),
+	}
+
+	switch element := element.(type) {
+	case Int64Valuable:
+		value, diags := element.ToInt64Value(ctx)
+
+		if !diags.HasError() && !value.IsNull() && !value.IsUnknown() {
+			key.number = new(big.Float).SetInt64(value.ValueInt64())
+		}
+	case Float64Valuable:
+		value, diags := element.ToFloat64Value(ctx)
+
+		if !diags.HasError() && !value.IsNull() && !value.IsUnknown() {
+			key.number = big.NewFloat(value.ValueFloat64())
+		}
+	case NumberValuable:
+		value, diags := element.ToNumberValue(ctx)
+
+		if !diags.HasError() && !value.IsNull() && !value.IsUnknown() {
+			key.number = value.ValueBigFloat()
+		}
+	}
+
+	return key
+}
+
+// less returns true if the key sorts before the other key. Numbers sort
+// before any other values, so the ordering is consistent.
+func (k setListKey) less(o setListKey) bool {
+	switch {
+	case k.number != nil && o.number != nil:
+		if cmp := k.number.Cmp(o.number); cmp != 0 {
+			return cmp < 0
+		}
+	case k.number != nil:
+		return true
+	case o.number != nil:
+		return false
+	}
+
+	return k.str < o.str
+}
@@ -2,6 +2,7 @@ package basetypes
 
 import (
 	"context"
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -1173,6 +1174,86 @@ func TestSetValueIsUnknown(t *testing.T) {
 	}
 }
 
+func TestSetValueIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected bool
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test1"), NewStringValue("test2")}),
+			expected: false,
+		},
+		"known-empty": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: true,
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsEmpty()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueLen(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected int
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test1"), NewStringValue("test2")}),
+			expected: 2,
+		},
+		"known-empty": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: 0,
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: 0,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Len()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetValueString(t *testing.T) {
 	t.Parallel()
 
@@ -1310,3 +1391,125 @@ func TestSetValueType(t *testing.T) {
 		})
 	}
 }
+
+func TestSetValueToList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected ListValue
+	}{
+		"known": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("c"),
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringValue("c"),
+			}),
+		},
+		"known-reordered": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("c"),
+				NewStringValue("a"),
+			}),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringValue("c"),
+			}),
+		},
+		"known-float64": {
+			input: NewSetValueMust(Float64Type{}, []attr.Value{
+				NewFloat64Value(10.5),
+				NewFloat64Value(-1),
+				NewFloat64Value(9.25),
+			}),
+			expected: NewListValueMust(Float64Type{}, []attr.Value{
+				NewFloat64Value(-1),
+				NewFloat64Value(9.25),
+				NewFloat64Value(10.5),
+			}),
+		},
+		"known-int64": {
+			input: NewSetValueMust(Int64Type{}, []attr.Value{
+				NewInt64Value(10),
+				NewInt64Value(9),
+				NewInt64Value(100),
+			}),
+			expected: NewListValueMust(Int64Type{}, []attr.Value{
+				NewInt64Value(9),
+				NewInt64Value(10),
+				NewInt64Value(100),
+			}),
+		},
+		"known-int64-null": {
+			input: NewSetValueMust(Int64Type{}, []attr.Value{
+				NewInt64Null(),
+				NewInt64Value(10),
+				NewInt64Value(9),
+			}),
+			expected: NewListValueMust(Int64Type{}, []attr.Value{
+				NewInt64Value(9),
+				NewInt64Value(10),
+				NewInt64Null(),
+			}),
+		},
+		"known-number": {
+			input: NewSetValueMust(NumberType{}, []attr.Value{
+				NewNumberValue(big.NewFloat(10)),
+				NewNumberValue(big.NewFloat(9)),
+				NewNumberValue(big.NewFloat(-0.5)),
+			}),
+			expected: NewListValueMust(NumberType{}, []attr.Value{
+				NewNumberValue(big.NewFloat(-0.5)),
+				NewNumberValue(big.NewFloat(9)),
+				NewNumberValue(big.NewFloat(10)),
+			}),
+		},
+		"known-list-elements": {
+			input: NewSetValueMust(ListType{ElemType: StringType{}}, []attr.Value{
+				NewListValueMust(StringType{}, []attr.Value{NewStringValue("b")}),
+				NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			}),
+			expected: NewListValueMust(ListType{ElemType: StringType{}}, []attr.Value{
+				NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+				NewListValueMust(StringType{}, []attr.Value{NewStringValue("b")}),
+			}),
+		},
+		"known-empty": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: NewListNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: NewListUnknown(StringType{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ToList(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
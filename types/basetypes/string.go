@@ -57,6 +57,20 @@ func NewStringValue(value string) StringValue {
 	}
 }
 
+// NewStringValueOrNull creates a String with a known value, unless the given
+// value is equal to any of the nullWhen sentinel values, in which case a null
+// String is created. This is useful for mapping APIs which use a sentinel
+// value, such as "", to represent an unset value.
+func NewStringValueOrNull(value string, nullWhen ...string) StringValue {
+	for _, sentinel := range nullWhen {
+		if value == sentinel {
+			return NewStringNull()
+		}
+	}
+
+	return NewStringValue(value)
+}
+
 // StringValue represents a UTF-8 string value.
 type StringValue struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -146,3 +160,33 @@ func (s StringValue) ValueString() string {
 func (s StringValue) ToStringValue(context.Context) (StringValue, diag.Diagnostics) {
 	return s, nil
 }
+
+// ToBoolValue returns the String parsed as a Bool. Only the known values
+// "true" and "false" are accepted, while any other value, such as "yes" or
+// "1", returns a null Bool with an error diagnostic. Null and unknown values
+// are converted to a null or unknown Bool.
+func (s StringValue) ToBoolValue() (BoolValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch s.state {
+	case attr.ValueStateNull:
+		return NewBoolNull(), nil
+	case attr.ValueStateUnknown:
+		return NewBoolUnknown(), nil
+	}
+
+	switch s.value {
+	case "true":
+		return NewBoolValue(true), nil
+	case "false":
+		return NewBoolValue(false), nil
+	}
+
+	diags.AddError(
+		"Bool Conversion Error",
+		fmt.Sprintf("The string value %q cannot be converted to a bool. ", s.value)+
+			`The value must be exactly "true" or "false".`,
+	)
+
+	return NewBoolNull(), diags
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -338,3 +339,128 @@ func TestStringValueValueString(t *testing.T) {
 		})
 	}
 }
+
+func TestStringValueToBoolValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         StringValue
+		expected      BoolValue
+		expectedDiags diag.Diagnostics
+	}{
+		"known-false": {
+			input:    NewStringValue("false"),
+			expected: NewBoolValue(false),
+		},
+		"known-true": {
+			input:    NewStringValue("true"),
+			expected: NewBoolValue(true),
+		},
+		"known-invalid-yes": {
+			input:    NewStringValue("yes"),
+			expected: NewBoolNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Bool Conversion Error",
+					`The string value "yes" cannot be converted to a bool. The value must be exactly "true" or "false".`,
+				),
+			},
+		},
+		"known-invalid-one": {
+			input:    NewStringValue("1"),
+			expected: NewBoolNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Bool Conversion Error",
+					`The string value "1" cannot be converted to a bool. The value must be exactly "true" or "false".`,
+				),
+			},
+		},
+		"known-invalid-uppercase": {
+			input:    NewStringValue("TRUE"),
+			expected: NewBoolNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Bool Conversion Error",
+					`The string value "TRUE" cannot be converted to a bool. The value must be exactly "true" or "false".`,
+				),
+			},
+		},
+		"null": {
+			input:    NewStringNull(),
+			expected: NewBoolNull(),
+		},
+		"unknown": {
+			input:    NewStringUnknown(),
+			expected: NewBoolUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ToBoolValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStringValueOrNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    string
+		nullWhen []string
+		expected StringValue
+	}{
+		"no-sentinels": {
+			value:    "test",
+			nullWhen: nil,
+			expected: NewStringValue("test"),
+		},
+		"value-matching-sentinel": {
+			value:    "",
+			nullWhen: []string{""},
+			expected: NewStringNull(),
+		},
+		"value-not-matching-sentinel": {
+			value:    "test",
+			nullWhen: []string{""},
+			expected: NewStringValue("test"),
+		},
+		"value-matching-multiple-sentinels": {
+			value:    "none",
+			nullWhen: []string{"", "none"},
+			expected: NewStringNull(),
+		},
+		"value-not-matching-multiple-sentinels": {
+			value:    "test",
+			nullWhen: []string{"", "none"},
+			expected: NewStringValue("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewStringValueOrNull(testCase.value, testCase.nullWhen...)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
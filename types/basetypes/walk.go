@@ -0,0 +1,160 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Walk calls fn for the given value and every value nested within it, such as
+// the attribute values of an object and the element values of a list, map,
+// or set, at any depth. The path passed to fn is relative to the given value,
+// which is visited first with an empty path. Values are visited before their
+// nested values, object attributes are visited in name order, map elements
+// are visited in key order, and list and set elements are visited in element
+// order. Null and unknown values have no nested values.
+//
+// Values implementing ListValuable, MapValuable, ObjectValuable, or
+// SetValuable with a custom type are visited with their custom value, while
+// their nested values are determined by converting to the base value.
+//
+// If fn returns an error, the walk stops and that error is returned. An error
+// is also returned if a custom value cannot be converted to its base value.
+//
+// This function is intended for logic which inspects an entire value, such as
+// searching for unknown values or flattening nested values.
+func Walk(ctx context.Context, v attr.Value, fn func(path.Path, attr.Value) error) error {
+	if v == nil {
+		return nil
+	}
+
+	return walk(ctx, path.Empty(), v, fn)
+}
+
+// walk calls fn for the value at the given path and its nested values.
+func walk(ctx context.Context, p path.Path, v attr.Value, fn func(path.Path, attr.Value) error) error {
+	if err := fn(p, v); err != nil {
+		return err
+	}
+
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	// Determine the kind of value from its type rather than the Go type of
+	// the value, since list and set values can convert to one another.
+	tfType := v.Type(ctx).TerraformType(ctx)
+
+	switch {
+	case tfType.Is(tftypes.List{}):
+		listValuable, ok := v.(ListValuable)
+
+		if !ok {
+			return nil
+		}
+
+		listValue, diags := listValuable.ToListValue(ctx)
+
+		if diags.HasError() {
+			return walkConversionError(p, v, diags)
+		}
+
+		for idx, element := range listValue.Elements() {
+			if err := walk(ctx, p.AtListIndex(idx), element, fn); err != nil {
+				return err
+			}
+		}
+	case tfType.Is(tftypes.Map{}):
+		mapValuable, ok := v.(MapValuable)
+
+		if !ok {
+			return nil
+		}
+
+		mapValue, diags := mapValuable.ToMapValue(ctx)
+
+		if diags.HasError() {
+			return walkConversionError(p, v, diags)
+		}
+
+		elements := mapValue.Elements()
+		keys := make([]string, 0, len(elements))
+
+		for key := range elements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if err := walk(ctx, p.AtMapKey(key), elements[key], fn); err != nil {
+				return err
+			}
+		}
+	case tfType.Is(tftypes.Object{}):
+		objectValuable, ok := v.(ObjectValuable)
+
+		if !ok {
+			return nil
+		}
+
+		objectValue, diags := objectValuable.ToObjectValue(ctx)
+
+		if diags.HasError() {
+			return walkConversionError(p, v, diags)
+		}
+
+		attributes := objectValue.Attributes()
+		names := make([]string, 0, len(attributes))
+
+		for name := range attributes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			if err := walk(ctx, p.AtName(name), attributes[name], fn); err != nil {
+				return err
+			}
+		}
+	case tfType.Is(tftypes.Set{}):
+		setValuable, ok := v.(SetValuable)
+
+		if !ok {
+			return nil
+		}
+
+		setValue, diags := setValuable.ToSetValue(ctx)
+
+		if diags.HasError() {
+			return walkConversionError(p, v, diags)
+		}
+
+		for _, element := range setValue.Elements() {
+			if err := walk(ctx, p.AtSetValue(element), element, fn); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// walkConversionError returns an error for a value which could not be
+// converted to its base value type.
+func walkConversionError(p path.Path, v attr.Value, diags diag.Diagnostics) error {
+	details := make([]string, 0, len(diags))
+
+	for _, d := range diags.Errors() {
+		details = append(details, d.Summary()+": "+d.Detail())
+	}
+
+	return fmt.Errorf("unable to convert %T value at path %q: %s", v, p, strings.Join(details, "; "))
+}
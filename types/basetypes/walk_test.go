@@ -0,0 +1,163 @@
+package basetypes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	ruleType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": StringType{},
+			"port": Int64Type{},
+		},
+	}
+
+	ruleA := NewObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+		"name": NewStringValue("a"),
+		"port": NewInt64Value(80),
+	})
+	ruleB := NewObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+		"name": NewStringUnknown(),
+		"port": NewInt64Null(),
+	})
+	rules := NewListValueMust(ruleType, []attr.Value{ruleA, ruleB})
+	tags := NewMapValueMust(StringType{}, map[string]attr.Value{
+		"z": NewStringValue("last"),
+		"a": NewStringValue("first"),
+	})
+	zones := NewSetValueMust(StringType{}, []attr.Value{
+		NewStringValue("zone-1"),
+	})
+	nested := NewObjectValueMust(
+		map[string]attr.Type{
+			"id":    StringType{},
+			"rules": ListType{ElemType: ruleType},
+			"tags":  MapType{ElemType: StringType{}},
+			"zones": SetType{ElemType: StringType{}},
+		},
+		map[string]attr.Value{
+			"id":    NewStringValue("test"),
+			"rules": rules,
+			"tags":  tags,
+			"zones": zones,
+		},
+	)
+
+	type visit struct {
+		Path  path.Path
+		Value attr.Value
+	}
+
+	testCases := map[string]struct {
+		value       attr.Value
+		fnErr       func(path.Path) error
+		expected    []visit
+		expectedErr error
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"primitive": {
+			value: NewStringValue("test"),
+			expected: []visit{
+				{Path: path.Empty(), Value: NewStringValue("test")},
+			},
+		},
+		"null-object": {
+			value: NewObjectNull(ruleType.AttrTypes),
+			expected: []visit{
+				{Path: path.Empty(), Value: NewObjectNull(ruleType.AttrTypes)},
+			},
+		},
+		"unknown-list": {
+			value: NewListUnknown(ruleType),
+			expected: []visit{
+				{Path: path.Empty(), Value: NewListUnknown(ruleType)},
+			},
+		},
+		"nested-object-with-list-of-objects": {
+			value: nested,
+			expected: []visit{
+				{Path: path.Empty(), Value: nested},
+				{Path: path.Root("id"), Value: NewStringValue("test")},
+				{Path: path.Root("rules"), Value: rules},
+				{Path: path.Root("rules").AtListIndex(0), Value: ruleA},
+				{Path: path.Root("rules").AtListIndex(0).AtName("name"), Value: NewStringValue("a")},
+				{Path: path.Root("rules").AtListIndex(0).AtName("port"), Value: NewInt64Value(80)},
+				{Path: path.Root("rules").AtListIndex(1), Value: ruleB},
+				{Path: path.Root("rules").AtListIndex(1).AtName("name"), Value: NewStringUnknown()},
+				{Path: path.Root("rules").AtListIndex(1).AtName("port"), Value: NewInt64Null()},
+				{Path: path.Root("tags"), Value: tags},
+				{Path: path.Root("tags").AtMapKey("a"), Value: NewStringValue("first")},
+				{Path: path.Root("tags").AtMapKey("z"), Value: NewStringValue("last")},
+				{Path: path.Root("zones"), Value: zones},
+				{Path: path.Root("zones").AtSetValue(NewStringValue("zone-1")), Value: NewStringValue("zone-1")},
+			},
+		},
+		"callback-error": {
+			value: nested,
+			fnErr: func(p path.Path) error {
+				if p.Equal(path.Root("rules").AtListIndex(0)) {
+					return errors.New("test error")
+				}
+
+				return nil
+			},
+			expected: []visit{
+				{Path: path.Empty(), Value: nested},
+				{Path: path.Root("id"), Value: NewStringValue("test")},
+				{Path: path.Root("rules"), Value: rules},
+				{Path: path.Root("rules").AtListIndex(0), Value: ruleA},
+			},
+			expectedErr: errors.New("test error"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			err := Walk(context.Background(), testCase.value, func(p path.Path, v attr.Value) error {
+				got = append(got, visit{Path: p, Value: v})
+
+				if testCase.fnErr != nil {
+					return testCase.fnErr(p)
+				}
+
+				return nil
+			})
+
+			if err != nil {
+				if testCase.expectedErr == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedErr, err)
+				}
+			}
+
+			if err == nil && testCase.expectedErr != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -21,3 +21,10 @@ func BoolUnknown() basetypes.BoolValue {
 func BoolValue(value bool) basetypes.BoolValue {
 	return basetypes.NewBoolValue(value)
 }
+
+// BoolValueOrNull creates a Bool with a known value, unless the given value
+// is equal to any of the nullWhen sentinel values, in which case a null Bool
+// is created.
+func BoolValueOrNull(value bool, nullWhen ...bool) basetypes.BoolValue {
+	return basetypes.NewBoolValueOrNull(value, nullWhen...)
+}
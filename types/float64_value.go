@@ -21,3 +21,10 @@ func Float64Unknown() basetypes.Float64Value {
 func Float64Value(value float64) basetypes.Float64Value {
 	return basetypes.NewFloat64Value(value)
 }
+
+// Float64ValueOrNull creates a Float64 with a known value, unless the given value
+// is equal to any of the nullWhen sentinel values, in which case a null Float64
+// is created.
+func Float64ValueOrNull(value float64, nullWhen ...float64) basetypes.Float64Value {
+	return basetypes.NewFloat64ValueOrNull(value, nullWhen...)
+}
@@ -21,3 +21,10 @@ func Int64Unknown() basetypes.Int64Value {
 func Int64Value(value int64) basetypes.Int64Value {
 	return basetypes.NewInt64Value(value)
 }
+
+// Int64ValueOrNull creates a Int64 with a known value, unless the given value
+// is equal to any of the nullWhen sentinel values, in which case a null Int64
+// is created.
+func Int64ValueOrNull(value int64, nullWhen ...int64) basetypes.Int64Value {
+	return basetypes.NewInt64ValueOrNull(value, nullWhen...)
+}
@@ -25,3 +25,10 @@ func NumberUnknown() basetypes.NumberValue {
 func NumberValue(value *big.Float) basetypes.NumberValue {
 	return basetypes.NewNumberValue(value)
 }
+
+// NumberValueOrNull creates a Number with a known value, unless the given
+// value is nil or equal to any of the nullWhen sentinel values, in which case
+// a null Number is created.
+func NumberValueOrNull(value *big.Float, nullWhen ...*big.Float) basetypes.NumberValue {
+	return basetypes.NewNumberValueOrNull(value, nullWhen...)
+}
@@ -21,3 +21,10 @@ func StringUnknown() basetypes.StringValue {
 func StringValue(value string) basetypes.StringValue {
 	return basetypes.NewStringValue(value)
 }
+
+// StringValueOrNull creates a String with a known value, unless the given value
+// is equal to any of the nullWhen sentinel values, in which case a null String
+// is created.
+func StringValueOrNull(value string, nullWhen ...string) basetypes.StringValue {
+	return basetypes.NewStringValueOrNull(value, nullWhen...)
+}